@@ -96,17 +96,19 @@ pub async fn newhook(
         .await?;
     }
 
-    // Check webhook count
+    // Check webhook count against the guilds quota, an unset quota is unlimited
     let webhook_count = sqlx::query!(
-        "SELECT COUNT(1) FROM webhooks WHERE guild_id = $1",
+        "SELECT COUNT(1), (SELECT max_webhooks FROM guilds WHERE id = $1) AS max_webhooks FROM webhooks WHERE guild_id = $1",
         ctx.guild_id().unwrap().to_string()
     )
     .fetch_one(&data.pool)
     .await?;
 
-    if webhook_count.count.unwrap_or_default() >= 5 {
-        ctx.say("You can't have more than 5 webhooks per guild").await?;
-        return Ok(());
+    if let Some(max_webhooks) = webhook_count.max_webhooks {
+        if webhook_count.count.unwrap_or_default() >= max_webhooks.into() {
+            ctx.say(format!("You can't have more than {} webhooks in this guild", max_webhooks)).await?;
+            return Ok(());
+        }
     }
 
     // Create the webhook
@@ -228,8 +230,9 @@ pub async fn edithook(
     }
 
     if let Some(broken) = broken {
+        // Marking a webhook by hand clears the cause it was marked as broken for, so that it is not recovered automatically
         sqlx::query!(
-            "UPDATE webhooks SET broken = $1 WHERE id = $2 AND guild_id = $3",
+            "UPDATE webhooks SET broken = $1, broken_cause = NULL, broken_channel = NULL, broken_reason = NULL, broken_event = NULL, broken_payload = NULL WHERE id = $2 AND guild_id = $3",
             broken,
             id,
             ctx.guild_id().unwrap().to_string()
@@ -274,7 +277,32 @@ pub async fn newrepo(
     #[description = "The repo owner or organization"] owner: String,
     #[description = "The repo name"] name: String,
     #[description = "The channel to send to"] channel: ChannelId,
+    #[description = "Send one-line summaries instead of embeds"] one_line: Option<bool>,
+) -> Result<(), Error> { 
+    add_repo(ctx, webhook_id, (owner+"/"+&name).to_lowercase(), channel, one_line.unwrap_or_default()).await
+}
+
+/// Creates a new organization for a webhook, to receive organization-level events such as organization and team
+#[poise::command(slash_command, prefix_command, guild_only, guild_cooldown = 60, required_permissions = "MANAGE_GUILD")]
+pub async fn neworg(
+    ctx: Context<'_>,
+    #[description = "The webhook ID to use"] webhook_id: String,
+    #[description = "The organization login"] org: String,
+    #[description = "The channel to send to"] channel: ChannelId,
+    #[description = "Send one-line summaries instead of embeds"] one_line: Option<bool>,
 ) -> Result<(), Error> { 
+    // Organization-level events are matched on the organization login alone
+    add_repo(ctx, webhook_id, org.to_lowercase(), channel, one_line.unwrap_or_default()).await
+}
+
+/// Adds a repo (or an organization login) to a webhook, sending its events to a channel
+async fn add_repo(
+    ctx: Context<'_>,
+    webhook_id: String,
+    repo_name: String,
+    channel: ChannelId,
+    one_line: bool,
+) -> Result<(), Error> {
     let data = ctx.data();
 
     // Check if the guild exists on our DB
@@ -316,8 +344,6 @@ pub async fn newrepo(
             return Err("That webhook doesn't exist! Use ``/newhook`` (or ``git!newhook``) to create one".into());
         }
 
-        let repo_name = (owner+"/"+&name).to_lowercase();
-
         // Check if the repo exists
         let repo = sqlx::query!(
             "SELECT COUNT(1) FROM repos WHERE lower(repo_name) = $1 AND webhook_id = $2",
@@ -328,11 +354,25 @@ pub async fn newrepo(
         .await?;
 
         if repo.count.unwrap_or_default() == 0 {
+            // Check repo count against the guilds quota, an unset quota is unlimited
+            let repo_count = sqlx::query!(
+                "SELECT COUNT(1), (SELECT max_repos FROM guilds WHERE id = $1) AS max_repos FROM repos WHERE guild_id = $1",
+                ctx.guild_id().unwrap().to_string()
+            )
+            .fetch_one(&data.pool)
+            .await?;
+
+            if let Some(max_repos) = repo_count.max_repos {
+                if repo_count.count.unwrap_or_default() >= max_repos.into() {
+                    return Err(format!("You can't have more than {} repositories in this guild", max_repos).into());
+                }
+            }
+
             // If it doesn't, create it
             let id = Alphanumeric.sample_string(&mut rand::thread_rng(), 32);
 
             sqlx::query!(
-                "INSERT INTO repos (id, webhook_id, repo_name, channel_id, guild_id, created_by, last_updated_by) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+                "INSERT INTO repos (id, webhook_id, repo_name, channel_id, guild_id, created_by, last_updated_by, one_line) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
                 id,
                 webhook_id,
                 &repo_name,
@@ -340,6 +380,7 @@ pub async fn newrepo(
                 ctx.guild_id().unwrap().to_string(),
                 ctx.author().id.to_string(),
                 ctx.author().id.to_string(),
+                one_line,
             )
             .execute(&data.pool)
             .await?;
@@ -447,6 +488,38 @@ pub async fn setrepochannel(
     Ok(())
 }
 
+/// Sets whether a repository sends one-line summaries instead of embeds
+#[poise::command(slash_command, prefix_command, guild_only, guild_cooldown = 60, required_permissions = "MANAGE_GUILD")]
+pub async fn setrepooneline(
+    ctx: Context<'_>,
+    #[description = "The repo ID"] id: String,
+    #[description = "Whether to send one-line summaries"] one_line: bool,
+) -> Result<(), Error> { 
+    let data = ctx.data();
+
+    let res = sqlx::query!(
+        "UPDATE repos SET one_line = $1, last_updated_by = $2 WHERE id = $3 AND guild_id = $4",
+        one_line,
+        ctx.author().id.to_string(),
+        id,
+        ctx.guild_id().unwrap().to_string()
+    )
+    .execute(&data.pool)
+    .await?;
+
+    if res.rows_affected() == 0 {
+        return Err("That repo doesn't exist! Use ``/newrepo`` (or ``git!newrepo``) to create one".into());
+    }
+
+    if one_line {
+        ctx.say("The repository will now send one-line summaries").await?;
+    } else {
+        ctx.say("The repository will now send embeds").await?;
+    }
+
+    Ok(())
+}
+
 /// Resets a webhook secret. DMs must be open
 #[poise::command(slash_command, prefix_command, guild_only, guild_cooldown = 60, required_permissions = "MANAGE_GUILD")]
 pub async fn resetsecret(
@@ -0,0 +1,94 @@
+// Commands setting the default channel of a webhook, which receives events without a repository
+
+use poise::serenity_prelude::ChannelId;
+
+use crate::{Context, Error};
+
+/// Default channel base command
+#[poise::command(
+    category = "Default Channels",
+    prefix_command,
+    slash_command,
+    guild_cooldown = 10,
+    subcommands("set", "clear")
+)]
+pub async fn defaultchannel(_ctx: Context<'_>) -> Result<(), Error> {
+    Ok(())
+}
+
+/// Sets the channel events without a repository (such as GitHub App installation events) are sent to
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 10,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn set(
+    ctx: Context<'_>,
+    #[description = "The webhook ID"] webhook_id: String,
+    #[description = "The channel to send events without a repository to"] channel: ChannelId,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    let res = sqlx::query!(
+        "UPDATE webhooks SET default_channel = $1, last_updated_at = NOW(), last_updated_by = $2 WHERE id = $3 AND guild_id = $4",
+        channel.to_string(),
+        ctx.author().id.to_string(),
+        webhook_id,
+        ctx.guild_id().unwrap().to_string()
+    )
+    .execute(&data.pool)
+    .await?;
+
+    if res.rows_affected() == 0 {
+        return Err(
+            "That webhook doesn't exist! Use ``/newhook`` (or ``git!newhook``) to create one"
+                .into(),
+        );
+    }
+
+    ctx.say(format!(
+        "Events without a repository will now be sent to <#{}>",
+        channel
+    ))
+    .await?;
+
+    Ok(())
+}
+
+/// Clears the default channel of a webhook, events without a repository are then ignored
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 10,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn clear(
+    ctx: Context<'_>,
+    #[description = "The webhook ID"] webhook_id: String,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    let res = sqlx::query!(
+        "UPDATE webhooks SET default_channel = NULL, last_updated_at = NOW(), last_updated_by = $1 WHERE id = $2 AND guild_id = $3",
+        ctx.author().id.to_string(),
+        webhook_id,
+        ctx.guild_id().unwrap().to_string()
+    )
+    .execute(&data.pool)
+    .await?;
+
+    if res.rows_affected() == 0 {
+        return Err(
+            "That webhook doesn't exist! Use ``/newhook`` (or ``git!newhook``) to create one"
+                .into(),
+        );
+    }
+
+    ctx.say("Default channel cleared, events without a repository will now be ignored")
+        .await?;
+
+    Ok(())
+}
@@ -0,0 +1,192 @@
+// Digest related commands, digests buffer the events of a destination channel into one summary
+
+use poise::serenity_prelude::{ChannelId, CreateEmbed};
+use poise::CreateReply;
+use rand::distributions::{Alphanumeric, DistString};
+
+use crate::{Context, Error};
+
+/// Digest base command
+#[poise::command(
+    category = "Digests",
+    prefix_command,
+    slash_command,
+    guild_cooldown = 10,
+    subcommands("create", "list", "delete")
+)]
+pub async fn digest(_ctx: Context<'_>) -> Result<(), Error> {
+    Ok(())
+}
+
+/// Buffers events sent to a channel and posts them as one summary every few minutes
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 60,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn create(
+    ctx: Context<'_>,
+    #[description = "The webhook ID"] webhook_id: String,
+    #[description = "The channel whose events are digested"] channel: ChannelId,
+    #[description = "The events to digest, comma/space seperated (e.g. star watch workflow_*)"]
+    events: String,
+    #[description = "Post the digest after this many minutes, defaults to 60"]
+    interval_minutes: Option<i32>,
+    #[description = "Post the digest once this many events are buffered, defaults to 50"]
+    max_events: Option<i32>,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    // Check if the webhook exists
+    let webhook = sqlx::query!(
+        "SELECT COUNT(1) FROM webhooks WHERE id = $1 AND guild_id = $2",
+        webhook_id,
+        ctx.guild_id().unwrap().to_string()
+    )
+    .fetch_one(&data.pool)
+    .await?;
+
+    if webhook.count.unwrap_or_default() == 0 {
+        return Err(
+            "That webhook doesn't exist! Use ``/newhook`` (or ``git!newhook``) to create one"
+                .into(),
+        );
+    }
+
+    let events = events
+        .replace('`', "")
+        .replace(',', " ")
+        .split_whitespace()
+        .map(|s| s.to_string())
+        .collect::<Vec<String>>();
+
+    if events.is_empty() {
+        return Err("Please provide the events to digest, for example ``star watch push``".into());
+    }
+
+    let interval_minutes = interval_minutes.unwrap_or(60);
+
+    if !(1..=1440).contains(&interval_minutes) {
+        return Err("The interval must be between 1 and 1440 minutes".into());
+    }
+
+    let max_events = max_events.unwrap_or(50);
+
+    if !(1..=500).contains(&max_events) {
+        return Err("The maximum number of events must be between 1 and 500".into());
+    }
+
+    // Check the number of digests we already have
+    let digest_count = sqlx::query!(
+        "SELECT COUNT(1) FROM digests WHERE webhook_id = $1",
+        webhook_id
+    )
+    .fetch_one(&data.pool)
+    .await?;
+
+    if digest_count.count.unwrap_or_default() >= 10 {
+        return Err("You can only have 10 digests per webhook!".into());
+    }
+
+    let digest_id = Alphanumeric.sample_string(&mut rand::thread_rng(), 64);
+    sqlx::query!(
+        "INSERT INTO digests (id, guild_id, webhook_id, channel_id, events, interval_minutes, max_events, created_by, last_updated_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+        digest_id,
+        ctx.guild_id().unwrap().to_string(),
+        webhook_id,
+        channel.to_string(),
+        &events,
+        interval_minutes,
+        max_events,
+        ctx.author().id.to_string(),
+        ctx.author().id.to_string(),
+    )
+    .execute(&data.pool)
+    .await?;
+
+    ctx.say(format!("Digest created with ID ``{}``.", digest_id))
+        .await?;
+
+    Ok(())
+}
+
+/// Lists the digests of this guild
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 10,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn list(ctx: Context<'_>) -> Result<(), Error> {
+    let data = ctx.data();
+
+    let digests = sqlx::query!(
+        "SELECT id, webhook_id, channel_id, events, interval_minutes, max_events FROM digests WHERE guild_id = $1",
+        ctx.guild_id().unwrap().to_string()
+    )
+    .fetch_all(&data.pool)
+    .await?;
+
+    if digests.is_empty() {
+        ctx.say("This guild doesn't have any digests yet. Create one with ``/digest create`` (or ``git!digest create``)").await?;
+        return Ok(());
+    }
+
+    let mut cr = CreateReply::default().content("Here are all the digests in this guild:");
+
+    for digest in digests {
+        cr = cr.embed(
+            CreateEmbed::new()
+                .title(format!("Digest in <#{}>", digest.channel_id))
+                .field("Digest ID", digest.id, false)
+                .field("Webhook ID", digest.webhook_id, false)
+                .field("Events", digest.events.join(", "), false)
+                .field(
+                    "Posted",
+                    format!(
+                        "Every {} minutes or every {} events",
+                        digest.interval_minutes, digest.max_events
+                    ),
+                    false,
+                ),
+        );
+    }
+
+    ctx.send(cr).await?;
+
+    Ok(())
+}
+
+/// Deletes a digest by id, events already buffered in it are dropped
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 60,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn delete(
+    ctx: Context<'_>,
+    #[description = "The digest ID"] digest_id: String,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    let res = sqlx::query!(
+        "DELETE FROM digests WHERE guild_id = $1 AND id = $2",
+        ctx.guild_id().unwrap().to_string(),
+        digest_id
+    )
+    .execute(&data.pool)
+    .await?;
+
+    if res.rows_affected() == 0 {
+        return Err("That digest doesn't exist!".into());
+    }
+
+    ctx.say("Digest deleted!").await?;
+
+    Ok(())
+}
@@ -1,6 +1,6 @@
 // Event modifier related commands
 
-use poise::serenity_prelude::ChannelId;
+use poise::serenity_prelude::{ChannelId, RoleId};
 use rand::distributions::{Alphanumeric, DistString};
 
 use crate::{Context, Error};
@@ -38,6 +38,11 @@ pub async fn create(
     #[lazy]
     repo_id: Option<String>,
     #[description = "Redirect channel ID"] redirect_channel: Option<ChannelId>,
+    #[description = "Role to ping when the event matches"] mention_role: Option<RoleId>,
+    #[description = "Conditions for the ping, comma/space seperated (e.g. severity=critical|high)"]
+    mention_conditions: Option<String>,
+    #[description = "Send matched events as one-line plain-text summaries instead of embeds"]
+    one_line: Option<bool>,
 ) -> Result<(), Error> {
     let data = ctx.data();
 
@@ -112,6 +117,18 @@ pub async fn create(
             .map(|s| s.to_string())
             .collect::<Vec<String>>();
 
+        let mention_conditions = mention_conditions
+            .unwrap_or_default()
+            .replace('`', "")
+            .replace(',', " ")
+            .split_whitespace()
+            .map(|s| s.to_string())
+            .collect::<Vec<String>>();
+
+        if mention_conditions.iter().any(|c| !c.contains('=')) {
+            return Err("Mention conditions must be of the form ``key=value``, for example ``severity=critical|high`` or ``action=created``".into());
+        }
+
         // Check the number of modifiers we already have
         let modifier_count = sqlx::query!(
             "SELECT COUNT(1) FROM event_modifiers WHERE webhook_id = $1",
@@ -129,7 +146,7 @@ pub async fn create(
         // Create the event modifier
         let modifier_id = Alphanumeric.sample_string(&mut rand::thread_rng(), 256);
         sqlx::query!(
-            "INSERT INTO event_modifiers (id, webhook_id, events, repo_id, blacklisted, whitelisted, redirect_channel, guild_id, priority, created_by, last_updated_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+            "INSERT INTO event_modifiers (id, webhook_id, events, repo_id, blacklisted, whitelisted, redirect_channel, guild_id, priority, created_by, last_updated_by, mention_role, mention_conditions, one_line) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
             modifier_id,
             webhook_id,
             &events,
@@ -141,6 +158,9 @@ pub async fn create(
             priority.unwrap_or_default(),
             ctx.author().id.to_string(),
             ctx.author().id.to_string(),
+            mention_role.map(|r| r.to_string()),
+            &mention_conditions,
+            one_line.unwrap_or_default(),
         )
         .execute(&data.pool)
         .await?;
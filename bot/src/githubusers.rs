@@ -0,0 +1,100 @@
+// Commands linking GitHub users to Discord users, so events can mention them
+
+use poise::serenity_prelude::User;
+
+use crate::{Context, Error};
+
+/// GitHub user base command
+#[poise::command(
+    category = "GitHub Users",
+    prefix_command,
+    slash_command,
+    guild_cooldown = 10,
+    subcommands("link", "unlink")
+)]
+pub async fn githubuser(_ctx: Context<'_>) -> Result<(), Error> {
+    Ok(())
+}
+
+/// Links a GitHub login to a Discord user, who will be mentioned for review requests, assignments and @mentions
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 10,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn link(
+    ctx: Context<'_>,
+    #[description = "The GitHub login"] github_login: String,
+    #[description = "The Discord user to mention"] user: User,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    // Check if the guild exists on our DB
+    let guild = sqlx::query!(
+        "SELECT COUNT(1) FROM guilds WHERE id = $1",
+        ctx.guild_id().unwrap().to_string()
+    )
+    .fetch_one(&data.pool)
+    .await?;
+
+    if guild.count.unwrap_or_default() == 0 {
+        // If it doesn't, return a error
+        return Err("You don't have any webhooks in this guild! Use ``/newhook`` (or ``git!newhook``) to create one".into());
+    }
+
+    let github_login = github_login.trim().trim_start_matches('@').to_lowercase();
+
+    if github_login.is_empty() {
+        return Err("Please provide a GitHub login".into());
+    }
+
+    sqlx::query!(
+        "INSERT INTO github_users (guild_id, github_login, discord_id, created_by) VALUES ($1, $2, $3, $4) ON CONFLICT (guild_id, github_login) DO UPDATE SET discord_id = $3, created_by = $4",
+        ctx.guild_id().unwrap().to_string(),
+        github_login,
+        user.id.to_string(),
+        ctx.author().id.to_string(),
+    )
+    .execute(&data.pool)
+    .await?;
+
+    ctx.say(format!("Linked GitHub user ``{}`` to <@{}>.", github_login, user.id))
+        .await?;
+
+    Ok(())
+}
+
+/// Unlinks a GitHub login from its Discord user
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 10,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn unlink(
+    ctx: Context<'_>,
+    #[description = "The GitHub login"] github_login: String,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    let github_login = github_login.trim().trim_start_matches('@').to_lowercase();
+
+    let res = sqlx::query!(
+        "DELETE FROM github_users WHERE guild_id = $1 AND github_login = $2",
+        ctx.guild_id().unwrap().to_string(),
+        github_login
+    )
+    .execute(&data.pool)
+    .await?;
+
+    if res.rows_affected() == 0 {
+        return Err("That GitHub user isn't linked!".into());
+    }
+
+    ctx.say("GitHub user unlinked!").await?;
+
+    Ok(())
+}
@@ -13,6 +13,10 @@ mod core;
 mod backups;
 mod config;
 mod eventmods;
+mod githubusers;
+mod digests;
+mod defaultchannel;
+mod settings;
 
 pub const VERSION: &str = env!("CARGO_PKG_VERSION");
 
@@ -146,13 +150,19 @@ async fn main() {
                 core::list(),
                 core::newhook(),
                 core::newrepo(),
+                core::neworg(),
                 core::delhook(),
                 core::delrepo(),
                 core::setrepochannel(),
+                core::setrepooneline(),
                 core::resetsecret(),
                 backups::backup(),
                 backups::restore(),
                 eventmods::eventmod(),
+                githubusers::githubuser(),
+                digests::digest(),
+                defaultchannel::defaultchannel(),
+                settings::settings(),
             ],
             // This code is run before every command
             pre_command: |ctx| {
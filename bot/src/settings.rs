@@ -0,0 +1,281 @@
+// Commands changing the settings of a guild, such as how events over the rate limit are handled
+
+use poise::serenity_prelude::ChannelId;
+
+use crate::{Context, Error};
+
+/// Guild settings base command
+#[poise::command(
+    category = "Settings",
+    prefix_command,
+    slash_command,
+    guild_cooldown = 10,
+    subcommands("ratelimit", "fallbackchannel", "locale", "color", "embedstyle")
+)]
+pub async fn settings(_ctx: Context<'_>) -> Result<(), Error> {
+    Ok(())
+}
+
+/// Shows or changes the rate limits of the guild and what happens to events over them
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 10,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn ratelimit(
+    ctx: Context<'_>,
+    #[description = "What to do with events over the rate limit: queue, collapse or drop"]
+    policy: Option<String>,
+    #[description = "Messages per minute per channel, 0 to disable"] channel_limit: Option<i32>,
+    #[description = "Messages per minute per webhook, 0 to disable"] webhook_limit: Option<i32>,
+    #[description = "Messages that can be sent at once before being rate limited"] burst: Option<
+        i32,
+    >,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    let policy = policy.map(|p| p.to_lowercase());
+
+    if let Some(ref policy) = policy {
+        if !["queue", "collapse", "drop"].contains(&policy.as_str()) {
+            return Err("The policy must be one of ``queue``, ``collapse`` or ``drop``".into());
+        }
+    }
+
+    if channel_limit.unwrap_or_default() < 0 || webhook_limit.unwrap_or_default() < 0 {
+        return Err("Rate limits can't be negative, use 0 to disable them".into());
+    }
+
+    if let Some(burst) = burst {
+        if !(1..=50).contains(&burst) {
+            return Err("The burst must be between 1 and 50 messages".into());
+        }
+    }
+
+    // Options that are not given keep their current value
+    let settings = sqlx::query!(
+        "UPDATE guilds SET rate_limit_policy = COALESCE($1, rate_limit_policy), channel_rate_limit = COALESCE($2, channel_rate_limit), webhook_rate_limit = COALESCE($3, webhook_rate_limit), rate_limit_burst = COALESCE($4, rate_limit_burst) WHERE id = $5 RETURNING rate_limit_policy, channel_rate_limit, webhook_rate_limit, rate_limit_burst",
+        policy,
+        channel_limit,
+        webhook_limit,
+        burst,
+        ctx.guild_id().unwrap().to_string()
+    )
+    .fetch_optional(&data.pool)
+    .await?;
+
+    let settings = match settings {
+        Some(settings) => settings,
+        None => return Err("You don't have any webhooks in this guild! Use ``/newhook`` (or ``git!newhook``) to create one".into()),
+    };
+
+    let limit = |limit: i32| {
+        if limit == 0 {
+            "unlimited".to_string()
+        } else {
+            format!("{} messages per minute", limit)
+        }
+    };
+
+    ctx.say(format!(
+        "**Policy:** {}\n**Per channel:** {}\n**Per webhook:** {}\n**Burst:** {} messages",
+        settings.rate_limit_policy,
+        limit(settings.channel_rate_limit),
+        limit(settings.webhook_rate_limit),
+        settings.rate_limit_burst
+    ))
+    .await?;
+
+    Ok(())
+}
+
+/// Sets the channel notices about broken webhooks and failed deliveries are sent to, clears it if no channel is given
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 10,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn fallbackchannel(
+    ctx: Context<'_>,
+    #[description = "The channel to send notices to, leave empty to stop sending them"]
+    channel: Option<ChannelId>,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    let res = sqlx::query!(
+        "UPDATE guilds SET fallback_channel = $1 WHERE id = $2",
+        channel.map(|c| c.to_string()),
+        ctx.guild_id().unwrap().to_string()
+    )
+    .execute(&data.pool)
+    .await?;
+
+    if res.rows_affected() == 0 {
+        return Err("You don't have any webhooks in this guild! Use ``/newhook`` (or ``git!newhook``) to create one".into());
+    }
+
+    match channel {
+        Some(channel) => {
+            ctx.say(format!(
+                "Notices about broken webhooks will now be sent to <#{}>",
+                channel
+            ))
+            .await?
+        }
+        None => {
+            ctx.say(
+                "Fallback channel cleared, notices about broken webhooks will no longer be sent",
+            )
+            .await?
+        }
+    };
+
+    Ok(())
+}
+
+/// Sets the language events are rendered in
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 10,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn locale(
+    ctx: Context<'_>,
+    #[description = "The language to use: en (English), de (German) or pt (Portuguese)"]
+    locale: String,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    let locale = locale.to_lowercase();
+
+    if !["en", "de", "pt"].contains(&locale.as_str()) {
+        return Err("The locale must be one of ``en``, ``de`` or ``pt``".into());
+    }
+
+    let res = sqlx::query!(
+        "UPDATE guilds SET locale = $1 WHERE id = $2",
+        locale,
+        ctx.guild_id().unwrap().to_string()
+    )
+    .execute(&data.pool)
+    .await?;
+
+    if res.rows_affected() == 0 {
+        return Err("You don't have any webhooks in this guild! Use ``/newhook`` (or ``git!newhook``) to create one".into());
+    }
+
+    ctx.say(format!("Events will now be rendered in ``{}``", locale))
+        .await?;
+
+    Ok(())
+}
+
+/// Overrides the color of embeds with a meaning, resets it to the default if no color is given
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 10,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn color(
+    ctx: Context<'_>,
+    #[description = "The meaning to recolor: success, failure, warning, neutral, info or severe"]
+    meaning: String,
+    #[description = "The color in hex (such as 1abc9c), 000000 removes the color. Leave empty to reset"]
+    color: Option<String>,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    let meaning = meaning.to_lowercase();
+
+    if !["success", "failure", "warning", "neutral", "info", "severe"].contains(&meaning.as_str()) {
+        return Err("The meaning must be one of ``success``, ``failure``, ``warning``, ``neutral``, ``info`` or ``severe``".into());
+    }
+
+    // Colors are stored as integers, NULL meaning the default color
+    let value = match color {
+        Some(ref color) => match i32::from_str_radix(color.trim_start_matches('#'), 16) {
+            Ok(value) if (0..=0xFFFFFF).contains(&value) => Some(value),
+            _ => return Err("The color must be a hex color such as ``1abc9c``".into()),
+        },
+        None => None,
+    };
+
+    let res = sqlx::query!(
+        "UPDATE guilds SET color_success = CASE WHEN $1 = 'success' THEN $2 ELSE color_success END, color_failure = CASE WHEN $1 = 'failure' THEN $2 ELSE color_failure END, color_warning = CASE WHEN $1 = 'warning' THEN $2 ELSE color_warning END, color_neutral = CASE WHEN $1 = 'neutral' THEN $2 ELSE color_neutral END, color_info = CASE WHEN $1 = 'info' THEN $2 ELSE color_info END, color_severe = CASE WHEN $1 = 'severe' THEN $2 ELSE color_severe END WHERE id = $3",
+        meaning,
+        value,
+        ctx.guild_id().unwrap().to_string()
+    )
+    .execute(&data.pool)
+    .await?;
+
+    if res.rows_affected() == 0 {
+        return Err("You don't have any webhooks in this guild! Use ``/newhook`` (or ``git!newhook``) to create one".into());
+    }
+
+    match value {
+        Some(value) => {
+            ctx.say(format!(
+                "Embeds meaning ``{}`` will now be colored ``#{:06x}``",
+                meaning, value
+            ))
+            .await?
+        }
+        None => {
+            ctx.say(format!(
+                "Embeds meaning ``{}`` will now use the default color",
+                meaning
+            ))
+            .await?
+        }
+    };
+
+    Ok(())
+}
+
+/// Sets how the details of events are laid out
+#[poise::command(
+    slash_command,
+    prefix_command,
+    guild_only,
+    guild_cooldown = 10,
+    required_permissions = "MANAGE_GUILD"
+)]
+pub async fn embedstyle(
+    ctx: Context<'_>,
+    #[description = "full for a field per detail, compact for the details on one line"]
+    style: String,
+) -> Result<(), Error> {
+    let data = ctx.data();
+
+    let style = style.to_lowercase();
+
+    if !["full", "compact"].contains(&style.as_str()) {
+        return Err("The style must be one of ``full`` or ``compact``".into());
+    }
+
+    let res = sqlx::query!(
+        "UPDATE guilds SET embed_style = $1 WHERE id = $2",
+        style,
+        ctx.guild_id().unwrap().to_string()
+    )
+    .execute(&data.pool)
+    .await?;
+
+    if res.rows_affected() == 0 {
+        return Err("You don't have any webhooks in this guild! Use ``/newhook`` (or ``git!newhook``) to create one".into());
+    }
+
+    ctx.say(format!("Events will now use the ``{}`` embed style", style))
+        .await?;
+
+    Ok(())
+}
@@ -1,6 +1,22 @@
 CREATE TABLE guilds (
     id TEXT PRIMARY KEY NOT NULL,
-    banned BOOLEAN NOT NULL DEFAULT FALSE
+    banned BOOLEAN NOT NULL DEFAULT FALSE,
+    rate_limit_policy TEXT NOT NULL DEFAULT 'queue', -- What to do with messages over the rate limit, one of 'queue', 'collapse' or 'drop'
+    channel_rate_limit INTEGER NOT NULL DEFAULT 20, -- Messages per minute per destination channel, 0 to disable
+    webhook_rate_limit INTEGER NOT NULL DEFAULT 30, -- Messages per minute per webhook, 0 to disable
+    rate_limit_burst INTEGER NOT NULL DEFAULT 5, -- Messages that can be sent at once before being rate limited
+    fallback_channel TEXT, -- Channel to send notices about broken webhooks to
+    max_deliveries_per_hour INTEGER DEFAULT 5000 CHECK (max_deliveries_per_hour >= 0), -- Quota on deliveries accepted per hour, NULL for no quota
+    max_webhooks INTEGER DEFAULT 5 CHECK (max_webhooks >= 0), -- Quota on the number of webhooks, NULL for no quota
+    max_repos INTEGER DEFAULT 250 CHECK (max_repos >= 0), -- Quota on the number of repos, NULL for no quota
+    locale TEXT NOT NULL DEFAULT 'en', -- Language messages are rendered in, one of 'en', 'de' or 'pt'
+    embed_style TEXT NOT NULL DEFAULT 'full', -- 'full' for a field per detail, 'compact' for the details on one line
+    color_success INTEGER, -- Colors overriding the default palette (e.g. 65306 for 0x00ff1a), NULL to use the default
+    color_failure INTEGER,
+    color_warning INTEGER,
+    color_neutral INTEGER,
+    color_info INTEGER,
+    color_severe INTEGER -- Color of high and critical security alerts that are still open
 );
 
 CREATE TABLE webhooks (
@@ -8,6 +24,12 @@ CREATE TABLE webhooks (
     guild_id TEXT NOT NULL REFERENCES guilds(id) ON DELETE CASCADE ON UPDATE CASCADE,
     comment TEXT NOT NULL, -- A comment to help identify the webhook
     broken BOOLEAN NOT NULL DEFAULT FALSE, 
+    broken_cause TEXT, -- What the webhook was marked as broken for, 'channel' or 'render', NULL if marked by hand
+    broken_channel TEXT, -- Channel whose deliveries failed
+    broken_reason TEXT, -- Last error before the webhook was marked as broken
+    broken_event TEXT, -- Event and payload that could not be rendered, to render again on recovery
+    broken_payload TEXT,
+    default_channel TEXT, -- Channel to post events without a repository to, such as GitHub App installation events
     secret TEXT NOT NULL,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     created_by TEXT NOT NULL,
@@ -15,12 +37,20 @@ CREATE TABLE webhooks (
     last_updated_by TEXT NOT NULL
 );
 
+CREATE TABLE webhook_failures (
+    webhook_id TEXT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    channel_id TEXT NOT NULL,
+    consecutive_failures INTEGER NOT NULL DEFAULT 0, -- Deliveries to this channel that failed in a row, the webhook is marked as broken past a threshold
+    PRIMARY KEY (webhook_id, channel_id)
+);
+
 CREATE TABLE repos (
     id TEXT PRIMARY KEY NOT NULL,
     guild_id TEXT NOT NULL REFERENCES guilds(id) ON DELETE CASCADE ON UPDATE CASCADE,
     webhook_id TEXT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE ON UPDATE CASCADE,
     repo_name TEXT NOT NULL,
     channel_id TEXT NOT NULL, -- Channel ID to post to
+    one_line BOOLEAN NOT NULL DEFAULT false, -- Whether to post one-line plain-text summaries instead of embeds
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     created_by TEXT NOT NULL,
     last_updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
@@ -37,6 +67,9 @@ CREATE TABLE event_modifiers (
     whitelisted boolean not null default false, -- Whether or not only these events can be sent
     redirect_channel TEXT, -- Channel ID to redirect to, otherwise use default channel
     priority INTEGER NOT NULL, -- Priority to apply the modifiers in, applied in descending order
+    mention_role TEXT, -- Role to ping when this modifier matches an event
+    mention_conditions TEXT[] NOT NULL DEFAULT '{}', -- Conditions such as 'severity=critical|high' the event must meet for the role ping
+    one_line BOOLEAN NOT NULL DEFAULT false, -- Whether matched events are posted as one-line plain-text summaries instead of embeds
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     created_by TEXT NOT NULL,
     last_updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
@@ -47,5 +80,41 @@ create table webhook_logs (
     log_id text primary key not null,
     guild_id TEXT NOT NULL REFERENCES guilds(id) ON DELETE CASCADE ON UPDATE CASCADE,
     webhook_id text not null references webhooks (id) ON UPDATE CASCADE ON DELETE CASCADE,
-    entries text[] not null default '{}'
+    entries text[] not null default '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX webhook_logs_guild_id_created_at_idx ON webhook_logs (guild_id, created_at);
+
+CREATE TABLE digests (
+    id TEXT PRIMARY KEY NOT NULL,
+    guild_id TEXT NOT NULL REFERENCES guilds(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    webhook_id TEXT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE ON UPDATE CASCADE, -- Webhook to apply to
+    channel_id TEXT NOT NULL, -- Destination channel whose events are digested
+    events TEXT[] NOT NULL DEFAULT '{}', -- Events to buffer in this digest, supports wildcards
+    interval_minutes INTEGER NOT NULL DEFAULT 60, -- Flush the digest after this many minutes
+    max_events INTEGER NOT NULL DEFAULT 50, -- Flush the digest once this many events are buffered
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by TEXT NOT NULL,
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_updated_by TEXT NOT NULL
+);
+
+CREATE TABLE digest_entries (
+    id BIGSERIAL PRIMARY KEY, -- Insertion order, used to keep per-webhook ordering on flush
+    digest_id TEXT NOT NULL REFERENCES digests(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    event TEXT NOT NULL,
+    kind TEXT NOT NULL, -- What is counted in the digest summary, e.g. "pull_request opened"
+    title TEXT NOT NULL,
+    url TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE github_users (
+    guild_id TEXT NOT NULL REFERENCES guilds(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    github_login TEXT NOT NULL, -- Lowercased GitHub login
+    discord_id TEXT NOT NULL, -- Discord user to mention for this login
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by TEXT NOT NULL,
+    PRIMARY KEY (guild_id, github_login)
 );
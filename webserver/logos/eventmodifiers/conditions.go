@@ -0,0 +1,111 @@
+package eventmodifiers
+
+import (
+	"fmt"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// Shorthands for fields that live in a different place depending on the event, any other key
+// of a condition is a dot separated path into the payload (such as deployment_status.state)
+var conditionAliases = map[string][]string{
+	"severity": {
+		"alert.security_advisory.severity",
+		"alert.rule.security_severity_level",
+		"alert.rule.severity",
+		"alert.severity",
+		"security_advisory.severity",
+	},
+	"state": {
+		"deployment_status.state",
+		"state",
+	},
+	"conclusion": {
+		"workflow_run.conclusion",
+		"workflow_job.conclusion",
+		"check_run.conclusion",
+		"check_suite.conclusion",
+	},
+}
+
+// lookupPath returns the value at a dot separated path in a payload as a string
+func lookupPath(payload map[string]any, path string) (string, bool) {
+	var value any = payload
+
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]any)
+
+		if !ok {
+			return "", false
+		}
+
+		if value, ok = m[key]; !ok || value == nil {
+			return "", false
+		}
+	}
+
+	switch v := value.(type) {
+	case string, bool, float64:
+		return fmt.Sprint(v), true
+	default:
+		return "", false
+	}
+}
+
+// MatchConditions checks a payload against conditions of the form key=pattern, where patterns
+// support wildcards and | between alternatives (such as severity=critical|high). All conditions
+// must match, no conditions always match
+func MatchConditions(conditions []string, body []byte) bool {
+	if len(conditions) == 0 {
+		return true
+	}
+
+	var payload map[string]any
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return false
+	}
+
+	for _, condition := range conditions {
+		key, pattern, ok := strings.Cut(condition, "=")
+
+		if !ok {
+			return false
+		}
+
+		paths, ok := conditionAliases[strings.TrimSpace(key)]
+
+		if !ok {
+			paths = []string{strings.TrimSpace(key)}
+		}
+
+		var matched bool
+
+		for _, path := range paths {
+			value, ok := lookupPath(payload, path)
+
+			if !ok {
+				continue
+			}
+
+			for _, alternative := range strings.Split(pattern, "|") {
+				if IsMatch(strings.ToLower(strings.TrimSpace(alternative)), strings.ToLower(value)) {
+					matched = true
+					break
+				}
+			}
+
+			// Only the first path present in the payload is checked
+			break
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,32 @@
+package eventmodifiers
+
+import "testing"
+
+func TestMatchConditions(t *testing.T) {
+	dependabot := []byte(`{"action": "created", "alert": {"security_advisory": {"severity": "critical"}}}`)
+	deployment := []byte(`{"action": "created", "deployment_status": {"state": "failure"}, "deployment": {"environment": "production"}}`)
+
+	tests := []struct {
+		conditions []string
+		body       []byte
+		want       bool
+	}{
+		{nil, dependabot, true},
+		{[]string{"severity=critical"}, dependabot, true},
+		{[]string{"severity=high|Critical"}, dependabot, true},
+		{[]string{"severity=high"}, dependabot, false},
+		{[]string{"action=created", "severity=crit*"}, dependabot, true},
+		{[]string{"action=dismissed", "severity=critical"}, dependabot, false},
+		{[]string{"state=failure|error"}, deployment, true},
+		{[]string{"state=failure", "deployment.environment=prod*"}, deployment, true},
+		{[]string{"deployment.environment=staging"}, deployment, false},
+		{[]string{"severity=critical"}, deployment, false},
+		{[]string{"malformed"}, deployment, false},
+	}
+
+	for _, tt := range tests {
+		if got := MatchConditions(tt.conditions, tt.body); got != tt.want {
+			t.Errorf("%v on %s: got %v, want %v", tt.conditions, tt.body, got, tt.want)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package eventmodifiers
 
 import (
+	"slices"
+
 	"github.com/git-logs/client/webserver/state"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -20,6 +22,12 @@ type EventCheck struct {
 	// Overridden by higher priority modifiers
 	// Only applies to whitelists
 	Overriden bool
+
+	// Roles to ping, from matching modifiers whose mention conditions are met
+	MentionRoles []string
+
+	// Whether to send a one-line summary instead of embeds, set by a matching modifier
+	OneLine bool
 }
 
 type EventModifier struct {
@@ -30,6 +38,14 @@ type EventModifier struct {
 	Whitelisted     bool
 	RedirectChannel string
 	Priority        int
+
+	// Role to ping when the modifier matches, and the conditions (such as severity=critical)
+	// the payload must meet for the ping
+	MentionRole       string
+	MentionConditions []string
+
+	// Whether matched events are sent as a one-line summary instead of embeds
+	OneLine bool
 }
 
 func GetEventModifiers(
@@ -37,7 +53,7 @@ func GetEventModifiers(
 	ghRepoId string,
 ) ([]*EventModifier, error) {
 	// Get all event_modifiers for webhook
-	rows, err := state.Pool.Query(state.Context, "SELECT id, repo_id, events, blacklisted, whitelisted, redirect_channel, priority FROM "+state.TableEventModifiers+" WHERE webhook_id = $1 ORDER BY priority DESC", webhookId)
+	rows, err := state.Pool.Query(state.Context, "SELECT id, repo_id, events, blacklisted, whitelisted, redirect_channel, priority, mention_role, mention_conditions, one_line FROM "+state.TableEventModifiers+" WHERE webhook_id = $1 ORDER BY priority DESC", webhookId)
 
 	if err != nil {
 		return nil, err
@@ -55,8 +71,11 @@ func GetEventModifiers(
 		var whitelisted bool
 		var redirectChannel pgtype.Text
 		var priority int
+		var mentionRole pgtype.Text
+		var mentionConditions []string
+		var oneLine bool
 
-		err = rows.Scan(&id, &repoId, &events, &blacklisted, &whitelisted, &redirectChannel, &priority)
+		err = rows.Scan(&id, &repoId, &events, &blacklisted, &whitelisted, &redirectChannel, &priority, &mentionRole, &mentionConditions, &oneLine)
 
 		if err != nil {
 			return nil, err
@@ -72,13 +91,16 @@ func GetEventModifiers(
 		}
 
 		modifiers = append(modifiers, &EventModifier{
-			ID:              id,
-			RepoID:          repoId.String,
-			Events:          events,
-			Blacklisted:     blacklisted,
-			Whitelisted:     whitelisted,
-			RedirectChannel: redirectChannel.String,
-			Priority:        priority,
+			ID:                id,
+			RepoID:            repoId.String,
+			Events:            events,
+			Blacklisted:       blacklisted,
+			Whitelisted:       whitelisted,
+			RedirectChannel:   redirectChannel.String,
+			Priority:          priority,
+			MentionRole:       mentionRole.String,
+			MentionConditions: mentionConditions,
+			OneLine:           oneLine,
 		})
 	}
 
@@ -89,6 +111,7 @@ func CheckEventAllowed(
 	webhookId string,
 	ghRepoId string,
 	ghEvent string,
+	body []byte,
 ) (*EventCheck, error) {
 	// Get all event_modifiers for webhook
 	modifiers, err := GetEventModifiers(webhookId, ghRepoId)
@@ -100,10 +123,15 @@ func CheckEventAllowed(
 	var resultantEventCheck *EventCheck = &EventCheck{}
 
 	for _, modifier := range modifiers {
+		// Modifiers set on a repo never apply to events without one
+		if ghRepoId == "" && modifier.RepoID != "" {
+			continue
+		}
+
 		// Check if the event is in the list of events
 		var matched bool
 		for _, event := range modifier.Events {
-			if isMatch(event, ghEvent) {
+			if IsMatch(event, ghEvent) {
 				matched = true
 				break
 			}
@@ -139,6 +167,14 @@ func CheckEventAllowed(
 			resultantEventCheck.Overriden = true
 		}
 
+		if modifier.MentionRole != "" && !slices.Contains(resultantEventCheck.MentionRoles, modifier.MentionRole) && MatchConditions(modifier.MentionConditions, body) {
+			resultantEventCheck.MentionRoles = append(resultantEventCheck.MentionRoles, modifier.MentionRole)
+		}
+
+		if modifier.OneLine {
+			resultantEventCheck.OneLine = true
+		}
+
 		// We cannot short-circuit here because we may have modifiers matching the same event
 	}
 
@@ -1,11 +1,13 @@
 package eventmodifiers
 
+// IsMatch checks s against the wildcard pattern p
+//
 // From https://golangbyexample.com/wildcard-matching-golang/
 //
 // * -> zero or more characters
 //
 // ? -> one character
-func isMatch(p string, s string) bool {
+func IsMatch(p string, s string) bool {
 	runeInput := []rune(s)
 	runePattern := []rune(p)
 
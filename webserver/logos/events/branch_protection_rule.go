@@ -1,40 +1,14 @@
 package events
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type bprRule struct {
-	ID        int    `json:"id"`
-	Name      string `json:"name"`
-	UpdatedAt string `json:"updated_at"`
-	CreatedAt string `json:"created_at"`
-
-	// Settings
-	AdminEnforced                            bool     `json:"admin_enforced"`
-	RequireCodeOwnerReview                   bool     `json:"require_code_owner_review"`
-	AllowDeletionsEnforcementLevel           string   `json:"allow_deletions_enforcement_level"`
-	AllowForcePushesEnforcementLevel         string   `json:"allow_force_pushes_enforcement_level"`
-	AuthorizedActorNames                     []string `json:"authorized_actor_names"`
-	AuthorizedActorsOnly                     bool     `json:"authorized_actors_only"`
-	AuthorizedDismissalActorsOnly            bool     `json:"authorized_dismissal_actors_only"`
-	CreateProtected                          bool     `json:"create_protected"`
-	DismissStaleReviewsOnPush                bool     `json:"dismiss_stale_reviews_on_push"`
-	IgnoreApprovalsFromContributors          bool     `json:"ignore_approvals_from_contributors"`
-	LinearHistoryRequirementEnforcementLevel string   `json:"linear_history_requirement_enforcement_level"`
-	MergeQueueEnforcementLevel               string   `json:"merge_queue_enforcement_level"`
-	PullRequestReviewsEnforcementLevel       string   `json:"pull_request_reviews_enforcement_level"`
-	RequiredAapprovingReviewCount            int      `json:"required_approving_review_count"`
-	RequiredConversationResolutionLevel      string   `json:"required_conversation_resolution_level"`
-	RequiredDeploymentsEnforcementLevel      string   `json:"required_deployments_enforcement_level"`
-	RequiredStatusChecks                     []string `json:"required_status_checks"`
-	SignatureRequirementEnforcementLevel     string   `json:"signature_requirement_enforcement_level"`
-	StrictRequiredStatusChecksPolicy         bool     `json:"strict_required_status_checks_policy"`
-}
-
-func (r bprRule) settings() string {
+func (r bprRule) settings(p *message.Printer) string {
 	// TODO, make the keys a bit more user friendly
 	settings := []KeyValue{
 		{
@@ -91,7 +65,7 @@ func (r bprRule) settings() string {
 		},
 		{
 			Key:   "Required approving review count",
-			Value: r.RequiredAapprovingReviewCount,
+			Value: r.RequiredApprovingReviewCount,
 		},
 		{
 			Key:   "Required conversation resolution",
@@ -123,21 +97,14 @@ func (r bprRule) settings() string {
 			break
 		}
 
+		setting.Key = translate(p, setting.Key)
 		settingsStr += setting.StringMD() + "\n"
 	}
 
 	return settingsStr
 }
 
-type BranchProtectionRuleEvent struct {
-	Action  string         `json:"action"`
-	Repo    Repository     `json:"repository"`
-	Sender  User           `json:"sender"`
-	Rule    bprRule        `json:"rule"`
-	Changes map[string]any `json:"changes"`
-}
-
-func branchProtectionRuleFn(bytes []byte) (*discordgo.MessageSend, error) {
+func branchProtectionRuleFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh BranchProtectionRuleEvent
 
 	// Unmarshal the JSON into our struct
@@ -150,17 +117,17 @@ func branchProtectionRuleFn(bytes []byte) (*discordgo.MessageSend, error) {
 	var color int
 	var title string
 	if gh.Action == "created" {
-		color = colorGreen
-		title = "New branch protection rule: " + gh.Repo.FullName
+		color = colorSuccess
+		title = p.Sprintf("New branch protection rule: %s", gh.Repository.FullName)
 	} else if gh.Action == "edited" {
-		color = colorYellow
-		title = "Branch protection rule edited: " + gh.Repo.FullName
+		color = colorWarning
+		title = p.Sprintf("Branch protection rule edited: %s", gh.Repository.FullName)
 	} else {
-		color = colorRed
-		title = "Branch protection rule deleted: " + gh.Repo.FullName
+		color = colorFailure
+		title = p.Sprintf("Branch protection rule deleted: %s", gh.Repository.FullName)
 	}
 
-	desc := "**Settings:**\n\n" + gh.Rule.settings()
+	desc := label(p, "Settings") + "\n\n" + gh.Rule.settings(p)
 
 	changes := []string{}
 
@@ -168,15 +135,18 @@ func branchProtectionRuleFn(bytes []byte) (*discordgo.MessageSend, error) {
 		changes = append(changes, k)
 	}
 
+	// Map iteration order is random, sort so the same event always renders the same
+	sort.Strings(changes)
+
 	if len(changes) > 0 {
-		desc += "\n\n**Changes:**\n\n" + strings.Join(changes, ", ")
+		desc += "\n\n" + label(p, "Changes") + "\n\n" + strings.Join(changes, ", ")
 	}
 
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
 				Color:       color,
-				URL:         gh.Repo.HTMLURL,
+				URL:         gh.Repository.HTMLURL,
 				Title:       title,
 				Author:      gh.Sender.AuthorEmbed(),
 				Description: desc,
@@ -190,3 +160,18 @@ func branchProtectionRuleFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func branchProtectionRuleSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh BranchProtectionRuleEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s branch protection rule %s", gh.Sender.Login, translateAction(p, gh.Action), gh.Rule.Name)
+
+	return summaryLine(gh.Repository.FullName, text, gh.Repository.HTMLURL+"/settings/branches"), nil
+}
@@ -1,27 +1,11 @@
 package events
 
 import (
-	"time"
-
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type CheckRunEvent struct {
-	Action   string     `json:"action"`
-	Repo     Repository `json:"repository"`
-	Sender   User       `json:"sender"`
-	CheckRun struct {
-		Name       string    `json:"name"`
-		HTMLURL    string    `json:"html_url"`
-		StartedAt  time.Time `json:"started_at"`
-		Status     string    `json:"status"`
-		DetailsURL string    `json:"details_url"`
-		Conclusion string    `json:"conclusion"`
-		HeadSHA    string    `json:"head_sha"`
-	} `json:"check_run"`
-}
-
-func checkRunFn(bytes []byte) (*discordgo.MessageSend, error) {
+func checkRunFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh CheckRunEvent
 
 	// Unmarshal the JSON into our struct
@@ -31,22 +15,30 @@ func checkRunFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
+	var color = conclusionColor(gh.CheckRun.Status, gh.CheckRun.Conclusion)
+
 	if gh.CheckRun.Conclusion == "" {
-		gh.CheckRun.Conclusion = "No conclusion yet!"
+		gh.CheckRun.Conclusion = p.Sprintf("No conclusion yet!")
+	}
+
+	// Completed check runs are stamped with when they finished rather than when they started
+	var timestamp = gh.CheckRun.StartedAt
+	if gh.CheckRun.CompletedAt != "" {
+		timestamp = gh.CheckRun.CompletedAt
 	}
 
 	if gh.CheckRun.Status == "" {
-		gh.CheckRun.Status = "No status yet!"
+		gh.CheckRun.Status = p.Sprintf("No status yet!")
 	}
 
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:     colorGreen,
-				URL:       gh.Repo.HTMLURL,
+				Color:     color,
+				URL:       gh.Repository.HTMLURL,
 				Author:    gh.Sender.AuthorEmbed(),
-				Title:     "Check Run " + gh.CheckRun.Name + " " + gh.Action + " on " + gh.Repo.FullName,
-				Timestamp: gh.CheckRun.StartedAt.Format(time.RFC3339),
+				Title:     p.Sprintf("Check Run %s %s on %s", gh.CheckRun.Name, translateAction(p, gh.Action), gh.Repository.FullName),
+				Timestamp: timestamp,
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:   "User",
@@ -83,3 +75,23 @@ func checkRunFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func checkRunSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh CheckRunEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var result = gh.CheckRun.Conclusion
+	if result == "" {
+		result = gh.CheckRun.Status
+	}
+
+	var text = p.Sprintf("Check run %s %s on %s", gh.CheckRun.Name, translateAction(p, result), gh.CheckRun.CheckSuite.HeadBranch)
+
+	return summaryLine(gh.Repository.FullName, text, gh.CheckRun.HTMLURL), nil
+}
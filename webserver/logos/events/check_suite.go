@@ -2,41 +2,10 @@ package events
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type CheckSuiteEvent struct {
-	Action     string     `json:"action"`
-	Repo       Repository `json:"repository"`
-	Sender     User       `json:"sender"`
-	CheckSuite struct {
-		ID         int    `json:"id"`
-		After      string `json:"after,omitempty"`
-		HeadBranch string `json:"head_branch,omitempty"`
-		HeadSHA    string `json:"head_sha,omitempty"`
-		Status     string `json:"status,omitempty"`
-		Conclusion string `json:"conclusion,omitempty"`
-		URL        string `json:"url,omitempty"`
-		Before     string `json:"before,omitempty"`
-		HeadCommit struct {
-			ID        string `json:"id,omitempty"`
-			TreeID    string `json:"tree_id,omitempty"`
-			Message   string `json:"message,omitempty"`
-			Timestamp string `json:"timestamp,omitempty"`
-			Author    struct {
-				Name     string `json:"name,omitempty"`
-				Email    string `json:"email,omitempty"`
-				Username string `json:"username,omitempty"`
-			} `json:"author,omitempty"`
-			Committer struct {
-				Name     string `json:"name,omitempty"`
-				Email    string `json:"email,omitempty"`
-				Username string `json:"username,omitempty"`
-			} `json:"committer,omitempty"`
-		} `json:"head_commit,omitempty"`
-	} `json:"check_suite"`
-}
-
-func checkSuiteFn(bytes []byte) (*discordgo.MessageSend, error) {
+func checkSuiteFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh CheckSuiteEvent
 
 	// Unmarshal the JSON into our struct
@@ -46,21 +15,23 @@ func checkSuiteFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
+	var color = conclusionColor(gh.CheckSuite.Status, gh.CheckSuite.Conclusion)
+
 	if gh.CheckSuite.Conclusion == "" {
-		gh.CheckSuite.Conclusion = "No conclusion yet!"
+		gh.CheckSuite.Conclusion = p.Sprintf("No conclusion yet!")
 	}
 
 	if gh.CheckSuite.Status == "" {
-		gh.CheckSuite.Status = "No status yet!"
+		gh.CheckSuite.Status = p.Sprintf("No status yet!")
 	}
 
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:  colorGreen,
-				URL:    gh.Repo.HTMLURL,
+				Color:  color,
+				URL:    gh.Repository.HTMLURL,
 				Author: gh.Sender.AuthorEmbed(),
-				Title:  "Check Suite " + gh.Action + " on " + gh.Repo.FullName,
+				Title:  p.Sprintf("Check Suite %s on %s", translateAction(p, gh.Action), gh.Repository.FullName),
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:   "User",
@@ -84,10 +55,30 @@ func checkSuiteFn(bytes []byte) (*discordgo.MessageSend, error) {
 					},
 					{
 						Name:  "Commit",
-						Value: gh.CheckSuite.HeadCommit.Message + " | " + gh.Repo.Commit(gh.CheckSuite.HeadCommit.ID),
+						Value: gh.CheckSuite.HeadCommit.Message + " | " + gh.Repository.Commit(gh.CheckSuite.HeadCommit.ID),
 					},
 				},
 			},
 		},
 	}, nil
 }
+
+func checkSuiteSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh CheckSuiteEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var result = gh.CheckSuite.Conclusion
+	if result == "" {
+		result = gh.CheckSuite.Status
+	}
+
+	var text = p.Sprintf("Check suite %s on %s (%s)", translateAction(p, result), gh.CheckSuite.HeadBranch, shortSHA(gh.CheckSuite.HeadSHA))
+
+	return summaryLine(gh.Repository.FullName, text, gh.Repository.HTMLURL+"/commit/"+gh.CheckSuite.HeadSHA), nil
+}
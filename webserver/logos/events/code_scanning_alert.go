@@ -0,0 +1,186 @@
+package events
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func codeScanningAlertFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh CodeScanningAlertEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	// Security rules have a severity level of their own, other rules only have note, warning or error
+	var severity = gh.Alert.Rule.SecuritySeverityLevel
+	if severity == "" {
+		severity = gh.Alert.Rule.Severity
+	}
+
+	var color int
+	var transition string
+	switch gh.Action {
+	case "created":
+		color = severityColor(severity)
+		transition = "created"
+	case "appeared_in_branch":
+		color = severityColor(severity)
+		transition = p.Sprintf("appeared in branch %s", strings.TrimPrefix(gh.Ref, "refs/heads/"))
+	case "reopened", "reopened_by_user":
+		color = severityColor(severity)
+		transition = "reopened"
+	case "closed_by_user":
+		color = colorNeutral
+		transition = "dismissed"
+	case "fixed":
+		color = colorSuccess
+		transition = "fixed"
+	default:
+		color = colorWarning
+		transition = strings.ReplaceAll(gh.Action, "_", " ")
+	}
+
+	var rule = gh.Alert.Rule.ID
+	if gh.Alert.Rule.HelpURI != "" {
+		rule = "[" + gh.Alert.Rule.ID + "](" + gh.Alert.Rule.HelpURI + ")"
+	}
+
+	if gh.Alert.Rule.Description != "" {
+		rule += "\n" + gh.Alert.Rule.Description
+	}
+
+	if len(rule) > 1020 {
+		rule = rule[:1020] + "..."
+	}
+
+	var tool = gh.Alert.Tool.Name
+	if gh.Alert.Tool.Version != "" {
+		tool += " " + gh.Alert.Tool.Version
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Tool",
+			Value:  tool,
+			Inline: true,
+		},
+		{
+			Name:   "Severity",
+			Value:  severity,
+			Inline: true,
+		},
+		{
+			Name:   "State",
+			Value:  gh.Alert.State,
+			Inline: true,
+		},
+		{
+			Name:  "Rule",
+			Value: rule,
+		},
+	}
+
+	instance := gh.Alert.MostRecentInstance
+
+	if instance.Location.Path != "" {
+		var location = instance.Location.Path
+		var lines = fmt.Sprintf("L%d", instance.Location.StartLine)
+
+		if instance.Location.EndLine > instance.Location.StartLine {
+			lines += fmt.Sprintf("-L%d", instance.Location.EndLine)
+		}
+
+		if instance.Location.StartLine > 0 {
+			location += "#" + lines
+		}
+
+		if instance.CommitSHA != "" {
+			location = "[" + location + "](" + gh.Repository.HTMLURL + "/blob/" + instance.CommitSHA + "/" + location + ")"
+		}
+
+		if instance.Ref != "" {
+			location += "\n" + label(p, "Ref") + " " + strings.TrimPrefix(instance.Ref, "refs/heads/")
+		}
+
+		if len(instance.CommitSHA) >= 7 {
+			location += "\n" + label(p, "Commit") + " " + gh.Repository.Commit(instance.CommitSHA)
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Location",
+			Value: location,
+		})
+	}
+
+	if gh.Action == "closed_by_user" {
+		var dismissed string
+
+		if gh.Alert.DismissedReason != "" {
+			dismissed += label(p, "Reason") + " " + gh.Alert.DismissedReason
+		}
+
+		if gh.Alert.DismissedComment != "" {
+			dismissed += "\n" + label(p, "Comment") + " " + gh.Alert.DismissedComment
+		}
+
+		if len(dismissed) > 1000 {
+			dismissed = dismissed[:1000] + "..."
+		}
+
+		if gh.Alert.DismissedBy != nil {
+			dismissed += "\n" + label(p, "Dismissed By") + " " + gh.Alert.DismissedBy.Link()
+		}
+
+		if dismissed != "" {
+			fields = append(fields, &discordgo.MessageEmbedField{
+				Name:  "Dismissal Details",
+				Value: dismissed,
+			})
+		}
+	}
+
+	var description = instance.Message.Text
+	if len(description) > 996 {
+		description = description[:996] + "..."
+	}
+
+	var embed = &discordgo.MessageEmbed{
+		Color:       color,
+		URL:         gh.Alert.HTMLURL,
+		Title:       p.Sprintf("Code scanning alert #%s %s on %s", strconv.Itoa(gh.Alert.Number), translate(p, transition), gh.Repository.FullName),
+		Description: description,
+		Fields:      fields,
+	}
+
+	// Alerts created or fixed by a scan are sent by GitHub itself and have no sender
+	if gh.Sender.Login != "" {
+		embed.Author = gh.Sender.AuthorEmbed()
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{embed},
+	}, nil
+}
+
+func codeScanningAlertSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh CodeScanningAlertEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("Code scanning alert #%s %s: %s", strconv.Itoa(gh.Alert.Number), translateAction(p, gh.Action), gh.Alert.Rule.Description)
+
+	return summaryLine(gh.Repository.FullName, text, gh.Alert.HTMLURL), nil
+}
@@ -2,21 +2,10 @@ package events
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type CommitCommentEvent struct {
-	Action  string     `json:"action"`
-	Repo    Repository `json:"repository"`
-	Sender  User       `json:"sender"`
-	Comment struct {
-		Body     string `json:"body"`
-		HTMLURL  string `json:"html_url"`
-		User     User   `json:"user"`
-		CommitID string `json:"commit_id"`
-	}
-}
-
-func commitCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
+func commitCommentFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh CommitCommentEvent
 
 	// Unmarshal the JSON into our struct
@@ -26,21 +15,20 @@ func commitCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
-	var comment string = gh.Comment.Body
-
-	if len(gh.Comment.Body) > 1000 {
-		comment = gh.Comment.Body[:1000] + "..."
-	}
+	var comment = formatBody(p, gh.Comment.Body, 1000, gh.Comment.HTMLURL)
 
 	if comment == "" {
-		comment = "No description available"
+		comment = p.Sprintf("No description available")
 	}
 
 	var color int
-	if gh.Action == "deleted" {
-		color = colorRed
-	} else {
-		color = colorGreen
+	switch gh.Action {
+	case "deleted":
+		color = colorFailure
+	case "edited":
+		color = colorWarning
+	default:
+		color = colorInfo
 	}
 
 	return &discordgo.MessageSend{
@@ -49,7 +37,7 @@ func commitCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 				Color:       color,
 				URL:         gh.Comment.HTMLURL,
 				Author:      gh.Sender.AuthorEmbed(),
-				Title:       "Comment on commit " + gh.Repo.FullName + " (" + gh.Comment.CommitID[:7] + ")",
+				Title:       p.Sprintf("Comment on commit %s (%s)", gh.Repository.FullName, shortSHA(gh.Comment.CommitID)),
 				Description: comment,
 				Fields: []*discordgo.MessageEmbedField{
 					{
@@ -59,7 +47,7 @@ func commitCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 					},
 					{
 						Name:   "Commit",
-						Value:  gh.Repo.Commit(gh.Comment.CommitID),
+						Value:  gh.Repository.Commit(gh.Comment.CommitID),
 						Inline: true,
 					},
 				},
@@ -67,3 +55,16 @@ func commitCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func commitCommentSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh CommitCommentEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, commentSummary(p, gh.Action, gh.Sender.Login, shortSHA(gh.Comment.CommitID), gh.Comment.Body), gh.Comment.HTMLURL), nil
+}
@@ -2,18 +2,10 @@ package events
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type CreateEvent struct {
-	Repo         Repository `json:"repository"`
-	Sender       User       `json:"sender"`
-	Ref          string     `json:"ref"`
-	RefType      string     `json:"ref_type"`
-	MasterBranch string     `json:"master_branch"`
-	PusherType   string     `json:"pusher_type"`
-}
-
-func createFn(bytes []byte) (*discordgo.MessageSend, error) {
+func createFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh CreateEvent
 
 	// Unmarshal the JSON into our struct
@@ -26,10 +18,10 @@ func createFn(bytes []byte) (*discordgo.MessageSend, error) {
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:  colorGreen,
-				URL:    gh.Repo.HTMLURL,
+				Color:  colorSuccess,
+				URL:    gh.Repository.HTMLURL,
 				Author: gh.Sender.AuthorEmbed(),
-				Title:  "New " + gh.RefType + " created on " + gh.Repo.FullName,
+				Title:  p.Sprintf("New %s created on %s", translate(p, gh.RefType), gh.Repository.FullName),
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:  "User",
@@ -60,3 +52,16 @@ func createFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func createSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh CreateEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, p.Sprintf("%s created %s", gh.Sender.Login, gh.Ref), gh.Repository.HTMLURL+"/tree/"+gh.Ref), nil
+}
@@ -2,17 +2,10 @@ package events
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type DeleteEvent struct {
-	Repo       Repository `json:"repository"`
-	Sender     User       `json:"sender"`
-	Ref        string     `json:"ref"`
-	RefType    string     `json:"ref_type"`
-	PusherType string     `json:"pusher_type"`
-}
-
-func deleteFn(bytes []byte) (*discordgo.MessageSend, error) {
+func deleteFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh DeleteEvent
 
 	// Unmarshal the JSON into our struct
@@ -25,10 +18,10 @@ func deleteFn(bytes []byte) (*discordgo.MessageSend, error) {
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:  colorRed,
-				URL:    gh.Repo.HTMLURL,
+				Color:  colorFailure,
+				URL:    gh.Repository.HTMLURL,
 				Author: gh.Sender.AuthorEmbed(),
-				Title:  "Removed " + gh.RefType + " from " + gh.Repo.FullName,
+				Title:  p.Sprintf("Removed %s from %s", translate(p, gh.RefType), gh.Repository.FullName),
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:  "User",
@@ -54,3 +47,16 @@ func deleteFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func deleteSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh DeleteEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, p.Sprintf("%s deleted %s", gh.Sender.Login, gh.Ref), gh.Repository.HTMLURL), nil
+}
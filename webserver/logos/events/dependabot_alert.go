@@ -1,42 +1,11 @@
 package events
 
-import "github.com/bwmarrin/discordgo"
-
-type DependabotAlertEvent struct {
-	Action string     `json:"action"`
-	Repo   Repository `json:"repository"`
-	Sender User       `json:"sender"`
-	Alert  struct {
-		HTMLURL    string `json:"html_url"`
-		State      string `json:"state"`
-		Dependency struct {
-			Package struct {
-				Name      string `json:"name"`
-				Ecosystem string `json:"ecosystem"`
-			} `json:"package"`
-			ManifestPath string `json:"manifest_path"`
-			Scope        string `json:"scope"`
-		} `json:"dependency"`
-		SecurityAdvisory struct {
-			Severity        string `json:"severity"`
-			GHSAID          string `json:"ghsa_id"`
-			CVEID           string `json:"cve_id"`
-			Summary         string `json:"summary"`
-			Description     string `json:"description"`
-			Vulnerabilities []struct {
-				Severity               string `json:"severity"`
-				VulnerableVersionRange string `json:"vulnerable_version_range"`
-				FirstPatchedVersion    struct {
-					Identifier string `json:"identifier"`
-				} `json:"first_patched_version"`
-			} `json:"vulnerabilities"`
-		} `json:"security_advisory"`
-		DismissedReason string `json:"dismissed_reason"`
-		DismissedBy     User   `json:"dismissed_by"`
-	} `json:"alert"`
-}
+import (
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
 
-func dependabotAlertFn(bytes []byte) (*discordgo.MessageSend, error) {
+func dependabotAlertFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh DependabotAlertEvent
 
 	// Unmarshal the JSON into our struct
@@ -47,16 +16,21 @@ func dependabotAlertFn(bytes []byte) (*discordgo.MessageSend, error) {
 	}
 
 	var color int
-	if gh.Action == "closed" {
-		color = colorRed
-	} else {
-		color = colorGreen
+	switch gh.Action {
+	case "created", "reopened", "reintroduced", "auto_reopened":
+		color = severityColor(gh.Alert.SecurityAdvisory.Severity)
+	case "fixed":
+		color = colorSuccess
+	case "dismissed", "auto_dismissed":
+		color = colorNeutral
+	default:
+		color = colorWarning
 	}
 
 	var details = gh.Alert.Dependency.Package.Name + " (" + gh.Alert.Dependency.Package.Ecosystem + ")"
 
 	if gh.Alert.Dependency.Scope != "" {
-		details += "\n**Scope:** " + gh.Alert.Dependency.Scope
+		details += "\n" + label(p, "Scope") + " " + gh.Alert.Dependency.Scope
 
 		if gh.Alert.Dependency.Scope == "runtime" {
 			details += " (this could be a highly critical vulnerability; runtime dependencies may not be checked by Dependabot)"
@@ -64,27 +38,23 @@ func dependabotAlertFn(bytes []byte) (*discordgo.MessageSend, error) {
 	}
 
 	if gh.Alert.Dependency.ManifestPath != "" {
-		details += "\n**Manifest Path:** " + gh.Alert.Dependency.ManifestPath
+		details += "\n" + label(p, "Manifest Path") + " " + gh.Alert.Dependency.ManifestPath
 	}
 
 	if gh.Alert.SecurityAdvisory.Severity != "" {
-		details += "\n**Severity:** " + gh.Alert.SecurityAdvisory.Severity
-
-		if gh.Alert.SecurityAdvisory.Severity == "high" || gh.Alert.SecurityAdvisory.Severity == "critical" {
-			color = colorDarkRed
-		}
+		details += "\n" + label(p, "Severity") + " " + gh.Alert.SecurityAdvisory.Severity
 	}
 
 	if gh.Alert.SecurityAdvisory.GHSAID != "" {
-		details += "\n**GHSA ID:** " + gh.Alert.SecurityAdvisory.GHSAID
+		details += "\n" + label(p, "GHSA ID") + " " + gh.Alert.SecurityAdvisory.GHSAID
 	}
 
 	if gh.Alert.SecurityAdvisory.CVEID != "" {
-		details += "\n**CVE:** CVE " + gh.Alert.SecurityAdvisory.CVEID
+		details += "\n" + label(p, "CVE") + " CVE " + gh.Alert.SecurityAdvisory.CVEID
 	}
 
 	if gh.Alert.State == "fixed" {
-		details += "\n**Could be fixed by resolving:** " + gh.Alert.Dependency.Package.Name + " " + gh.Alert.SecurityAdvisory.GHSAID
+		details += "\n" + label(p, "Could be fixed by resolving") + " " + gh.Alert.Dependency.Package.Name + " " + gh.Alert.SecurityAdvisory.GHSAID
 	}
 
 	if len(details) > 1020 {
@@ -94,7 +64,7 @@ func dependabotAlertFn(bytes []byte) (*discordgo.MessageSend, error) {
 	var summaryDet string
 
 	if gh.Alert.SecurityAdvisory.Summary != "" {
-		summaryDet += "\n**Summary:** " + gh.Alert.SecurityAdvisory.Summary
+		summaryDet += "\n" + label(p, "Summary") + " " + gh.Alert.SecurityAdvisory.Summary
 	}
 
 	if gh.Alert.SecurityAdvisory.Description != "" {
@@ -112,7 +82,7 @@ func dependabotAlertFn(bytes []byte) (*discordgo.MessageSend, error) {
 	var vulns string
 
 	for _, vuln := range gh.Alert.SecurityAdvisory.Vulnerabilities {
-		vulns += "\n**Severity:** " + vuln.Severity + "\n**Vulnerable Version Range:** " + vuln.VulnerableVersionRange + "\n**First Patched Version:** " + vuln.FirstPatchedVersion.Identifier + "\n"
+		vulns += "\n" + label(p, "Severity") + " " + vuln.Severity + "\n" + label(p, "Vulnerable Version Range") + " " + vuln.VulnerableVersionRange + "\n" + label(p, "First Patched Version") + " " + vuln.FirstPatchedVersion.Identifier + "\n"
 	}
 
 	if len(vulns) > 1020 {
@@ -122,19 +92,19 @@ func dependabotAlertFn(bytes []byte) (*discordgo.MessageSend, error) {
 	var dismissed string
 
 	if gh.Alert.DismissedReason != "" {
-		dismissed += "\n**Dismissed Reason:** " + gh.Alert.DismissedReason
+		dismissed += "\n" + label(p, "Dismissed Reason") + " " + gh.Alert.DismissedReason
 	}
 
 	if len(dismissed) > 1020 {
 		dismissed += dismissed[:1020] + "..."
 	}
 
-	if gh.Alert.DismissedBy.Login != "" {
-		dismissed += "\n**Dismissed By:** " + gh.Alert.DismissedBy.Link()
+	if gh.Alert.DismissedBy != nil {
+		dismissed += "\n" + label(p, "Dismissed By") + " " + gh.Alert.DismissedBy.Link()
 	}
 
 	if dismissed == "" {
-		dismissed = "Not dismissed"
+		dismissed = p.Sprintf("Not dismissed")
 	}
 
 	return &discordgo.MessageSend{
@@ -142,7 +112,7 @@ func dependabotAlertFn(bytes []byte) (*discordgo.MessageSend, error) {
 			{
 				Color: color,
 				URL:   gh.Alert.HTMLURL,
-				Title: "Dependabot Alert on " + gh.Repo.FullName + " " + gh.Alert.State,
+				Title: p.Sprintf("Dependabot Alert on %s %s", gh.Repository.FullName, translate(p, gh.Alert.State)),
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:   "URL",
@@ -179,3 +149,18 @@ func dependabotAlertFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func dependabotAlertSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh DependabotAlertEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("Dependabot alert %s for %s (%s)", translateAction(p, gh.Action), gh.Alert.Dependency.Package.Name, translate(p, gh.Alert.SecurityAdvisory.Severity))
+
+	return summaryLine(gh.Repository.FullName, text, gh.Alert.HTMLURL), nil
+}
@@ -2,29 +2,12 @@ package events
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type DeploymentEvent struct {
-	Action     string     `json:"action"`
-	Repo       Repository `json:"repository"`
-	Sender     User       `json:"sender"`
-	Deployment struct {
-		Creator               User      `json:"creator"`
-		CreatedAt             time.Time `json:"created_at"`
-		SHA                   string    `json:"sha"`
-		Description           string    `json:"description"`
-		OriginalEnvironment   string    `json:"original_environment"`
-		Environment           string    `json:"environment"`
-		ProductionEnvironment bool      `json:"production_environment"`
-		TransientEnvironment  bool      `json:"transient_environment"`
-		StatusesUrl           string    `json:"statuses_url"`
-	} `json:"deployment"`
-}
-
-func deploymentFn(bytes []byte) (*discordgo.MessageSend, error) {
+func deploymentFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh DeploymentEvent
 
 	// Unmarshal the JSON into our struct
@@ -35,11 +18,11 @@ func deploymentFn(bytes []byte) (*discordgo.MessageSend, error) {
 	}
 
 	var color int
-	var title string = "Deployment " + gh.Action + " on " + gh.Repo.FullName
+	var title string = p.Sprintf("Deployment %s on %s", translateAction(p, gh.Action), gh.Repository.FullName)
 	if gh.Action == "created" || gh.Action == "edited" {
-		color = colorGreen
+		color = colorSuccess
 	} else {
-		color = colorRed
+		color = colorFailure
 	}
 
 	var env = gh.Deployment.Environment
@@ -56,11 +39,11 @@ func deploymentFn(bytes []byte) (*discordgo.MessageSend, error) {
 		Embeds: []*discordgo.MessageEmbed{
 			{
 				Color:       color,
-				URL:         gh.Repo.HTMLURL,
+				URL:         gh.Repository.HTMLURL,
 				Title:       title,
 				Author:      gh.Deployment.Creator.AuthorEmbed(),
 				Description: gh.Deployment.Description,
-				Timestamp:   gh.Deployment.CreatedAt.Format(time.RFC3339),
+				Timestamp:   gh.Deployment.CreatedAt,
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:   "User",
@@ -74,7 +57,7 @@ func deploymentFn(bytes []byte) (*discordgo.MessageSend, error) {
 					},
 					{
 						Name:   "Commit",
-						Value:  gh.Repo.Commit(gh.Deployment.SHA),
+						Value:  gh.Repository.Commit(gh.Deployment.SHA),
 						Inline: true,
 					},
 					{
@@ -92,3 +75,24 @@ func deploymentFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func deploymentSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh DeploymentEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	// Older payloads do not send an action, and created is the only one there is
+	var action = gh.Action
+	if action == "" {
+		action = "created"
+	}
+
+	var text = p.Sprintf("%s %s a deployment of %s to %s", gh.Sender.Login, translateAction(p, action), shortSHA(gh.Deployment.SHA), gh.Deployment.Environment)
+
+	return summaryLine(gh.Repository.FullName, text, gh.Repository.HTMLURL+"/deployments"), nil
+}
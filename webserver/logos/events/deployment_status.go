@@ -1,32 +1,11 @@
 package events
 
 import (
-	"time"
-
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type DeploymentStatusEvent struct {
-	Action           string     `json:"action"`
-	Repo             Repository `json:"repository"`
-	Sender           User       `json:"sender"`
-	DeploymentStatus struct {
-		State          string    `json:"state"`
-		Creator        User      `json:"creator"`
-		CreatedAt      time.Time `json:"created_at"`
-		Description    string    `json:"description"`
-		EnvironmentURL string    `json:"environment_url"`
-		LogURL         string    `json:"log_url"`
-		TargetURL      string    `json:"target_url"`
-	} `json:"deployment_status"`
-	Deployment struct {
-		Task        string `json:"task"`
-		Description string `json:"description"`
-		SHA         string `json:"sha"`
-	}
-}
-
-func deploymentStatusFn(bytes []byte) (*discordgo.MessageSend, error) {
+func deploymentStatusFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh DeploymentStatusEvent
 
 	// Unmarshal the JSON into our struct
@@ -51,15 +30,15 @@ func deploymentStatusFn(bytes []byte) (*discordgo.MessageSend, error) {
 		emoji = "ℹ️"
 	}
 
-	var title string = emoji + " Deployment status updated on: " + gh.Repo.FullName + " (" + gh.Action + ")"
+	var title string = p.Sprintf("%s Deployment status updated on: %s (%s)", emoji, gh.Repository.FullName, translateAction(p, gh.Action))
 
 	var color int
 	if gh.DeploymentStatus.State == "success" {
-		color = colorGreen
+		color = colorSuccess
 	} else if gh.DeploymentStatus.State == "pending" {
-		color = colorYellow
+		color = colorWarning
 	} else {
-		color = colorRed
+		color = colorFailure
 	}
 
 	if len(gh.Deployment.Description) > 0 {
@@ -71,26 +50,26 @@ func deploymentStatusFn(bytes []byte) (*discordgo.MessageSend, error) {
 	}
 
 	if gh.DeploymentStatus.EnvironmentURL == "" {
-		gh.DeploymentStatus.EnvironmentURL = "No URL available"
+		gh.DeploymentStatus.EnvironmentURL = p.Sprintf("No URL available")
 	}
 
 	if gh.DeploymentStatus.LogURL == "" {
-		gh.DeploymentStatus.LogURL = "No URL available"
+		gh.DeploymentStatus.LogURL = p.Sprintf("No URL available")
 	}
 
 	if gh.DeploymentStatus.TargetURL == "" {
-		gh.DeploymentStatus.TargetURL = "No URL available"
+		gh.DeploymentStatus.TargetURL = p.Sprintf("No URL available")
 	}
 
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
 				Color:       color,
-				URL:         gh.Repo.HTMLURL,
+				URL:         gh.Repository.HTMLURL,
 				Title:       title,
 				Author:      gh.DeploymentStatus.Creator.AuthorEmbed(),
 				Description: gh.DeploymentStatus.Description,
-				Timestamp:   gh.DeploymentStatus.CreatedAt.Format(time.RFC3339),
+				Timestamp:   gh.DeploymentStatus.CreatedAt,
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:   "User",
@@ -99,7 +78,7 @@ func deploymentStatusFn(bytes []byte) (*discordgo.MessageSend, error) {
 					},
 					{
 						Name:   "Commit",
-						Value:  gh.Repo.Commit(gh.Deployment.SHA),
+						Value:  gh.Repository.Commit(gh.Deployment.SHA),
 						Inline: true,
 					},
 					{
@@ -127,3 +106,23 @@ func deploymentStatusFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func deploymentStatusSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh DeploymentStatusEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("Deployment of %s: %s", shortSHA(gh.Deployment.SHA), translateAction(p, gh.DeploymentStatus.State))
+
+	var url = gh.DeploymentStatus.TargetURL
+	if url == "" {
+		url = gh.Repository.HTMLURL + "/deployments"
+	}
+
+	return summaryLine(gh.Repository.FullName, text, url), nil
+}
@@ -1,39 +1,13 @@
 package events
 
 import (
-	"time"
+	"strconv"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type DiscussionEvent struct {
-	Action string     `json:"action"`     // The type of action for the event
-	Repo   Repository `json:"repository"` // The repository where this event was created
-	Sender User       `json:"sender"`     // The user who sent/created the event
-	Label  struct {
-		Name        string `json:"name"`        // Name of the discussion label
-		Default     bool   `json:"default"`     // Is the label our a default label
-		Description string `json:"description"` // Description of the label
-	} `json:"label"`
-	Discussion struct {
-		Title            string `json:"title"`              // The title of the origin discussion
-		Author           User   `json:"user"`               // The user who originally posted the discussion/answer
-		Created          string `json:"answer_chosen_at"`   // The date the answer was chosen at
-		AnswerSubmitter  User   `json:"answer_chosen_by"`   // The user who marked the answer as correct
-		ActiveLockReason string `json:"active_lock_reason"` // Reason for the discussion being locked (no comments allowed)
-		AnswerHtmlUrl    string `json:"answer_html_url"`    // URL To the answer/comment
-		AnswerRespBody   string `json:"body"`               // Body/content of the answer
-		Category         struct {
-			Name        string `json:"name"`          // Name of the discussion category
-			Answerable  bool   `json:"is_answerable"` // If the discussion can have a valid answer
-			Description string `json:"description"`   // The description of the discussion category
-		} `json:"category"`
-		DiscussionDate time.Time `json:"created_at"` // Date the discussion was created
-		DiscussionURL  string    `json:"html_url"`   // URL to the discussion
-	} `json:"discussion"`
-}
-
-func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
+func discussionFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh DiscussionEvent
 
 	// Unmarshall the json into our struct
@@ -48,46 +22,63 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 	// Someone posted a suggested answer/fix
 	case "answered":
 
-		var title string = "Discussion Answered"
+		var title string = p.Sprintf("Discussion Answered")
 
 		var locked = gh.Discussion.ActiveLockReason
 
 		if gh.Discussion.ActiveLockReason != "" {
-			locked = "Discussion is now Closed: " + gh.Discussion.ActiveLockReason
+			locked = p.Sprintf("Discussion is now Closed: %s", gh.Discussion.ActiveLockReason)
 		} else {
-			locked = "Discussion is still open for comments"
+			locked = p.Sprintf("Discussion is still open for comments")
+		}
+
+		// The answer is a comment of its own, older payloads without it show the discussion instead
+		var answer = gh.Answer
+		if answer.HTMLURL == "" {
+			answer.Body = gh.Discussion.Body
+			answer.User = gh.Discussion.User
+			answer.HTMLURL = gh.Discussion.AnswerHTMLURL
 		}
 
-		if len(gh.Discussion.AnswerRespBody) > 3000 {
-			gh.Discussion.AnswerRespBody = gh.Discussion.AnswerRespBody[:3000] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+		answer.Body = formatBody(p, answer.Body, 3000, answer.HTMLURL)
+
+		var timestamp = gh.Discussion.AnswerChosenAt
+		if timestamp == "" {
+			timestamp = gh.Discussion.CreatedAt
+		}
+
+		// The answer is chosen by whoever sent the event if the payload does not say
+		var chosenBy = gh.Sender
+		if gh.Discussion.AnswerChosenBy != nil {
+			chosenBy = *gh.Discussion.AnswerChosenBy
 		}
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					Color:       colorGreen,
-					URL:         gh.Repo.HTMLURL,
+					Color:       colorSuccess,
+					URL:         gh.Repository.HTMLURL,
 					Title:       title,
 					Author:      gh.Sender.AuthorEmbed(),
-					Description: gh.Discussion.AnswerRespBody,
+					Description: answer.Body,
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 						{
 							Name:   "Answer Selected By",
-							Value:  gh.Discussion.AnswerSubmitter.Link(),
+							Value:  chosenBy.Link(),
 							Inline: true,
 						},
 						{
 							Name:   "Answer Posted By",
-							Value:  gh.Discussion.Author.Link(),
+							Value:  answer.User.Link(),
 							Inline: true,
 						},
 						{
@@ -97,12 +88,12 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "View Answer",
-							Value:  gh.Discussion.AnswerHtmlUrl,
+							Value:  answer.HTMLURL,
 							Inline: true,
 						},
 						{
 							Name:   "View Discussion",
-							Value:  gh.Discussion.DiscussionURL,
+							Value:  gh.Discussion.HTMLURL,
 							Inline: true,
 						},
 						{
@@ -111,7 +102,7 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: timestamp,
 				},
 			},
 		}, nil
@@ -120,21 +111,21 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 	case "category_changed":
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					Color:       colorYellow,
-					URL:         gh.Repo.HTMLURL,
-					Title:       "Discussion Category Updated",
+					Color:       colorWarning,
+					URL:         gh.Repository.HTMLURL,
+					Title:       p.Sprintf("Discussion Category Updated"),
 					Author:      gh.Sender.AuthorEmbed(),
-					Description: "This discussion has been moved to a new category!",
+					Description: p.Sprintf("This discussion has been moved to a new category!"),
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 						{
@@ -148,7 +139,7 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -163,11 +154,11 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					Color:       colorRed,
-					URL:         gh.Repo.HTMLURL,
-					Title:       "Discussion Closed",
+					Color:       colorNeutral,
+					URL:         gh.Repository.HTMLURL,
+					Title:       p.Sprintf("Discussion Closed"),
 					Author:      gh.Sender.AuthorEmbed(),
-					Description: "This discussion has been closed and will no longer allow new comments/posts",
+					Description: p.Sprintf("This discussion has been closed and will no longer allow new comments/posts"),
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Discussion",
@@ -181,16 +172,16 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Created By",
-							Value:  gh.Discussion.Author.Link(),
+							Value:  gh.Discussion.User.Link(),
 							Inline: true,
 						},
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -199,17 +190,17 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 	case "reopened":
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					Color:       colorRed,
-					URL:         gh.Repo.HTMLURL,
-					Title:       "Discussion Reopened",
+					Color:       colorSuccess,
+					URL:         gh.Repository.HTMLURL,
+					Title:       p.Sprintf("Discussion Reopened"),
 					Author:      gh.Sender.AuthorEmbed(),
-					Description: "This discussion has been reopened",
+					Description: p.Sprintf("This discussion has been reopened"),
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Discussion",
@@ -223,16 +214,16 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Created By",
-							Value:  gh.Discussion.Author.Link(),
+							Value:  gh.Discussion.User.Link(),
 							Inline: true,
 						},
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -241,21 +232,19 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 	case "created":
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
-		if len(gh.Discussion.AnswerRespBody) > 3000 {
-			gh.Discussion.AnswerRespBody = gh.Discussion.AnswerRespBody[:3000] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
-		}
+		gh.Discussion.Body = formatBody(p, gh.Discussion.Body, 3000, gh.Discussion.HTMLURL)
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					URL:         gh.Repo.HTMLURL,
-					Color:       colorGreen,
-					Title:       "New Discussion Created",
+					URL:         gh.Repository.HTMLURL,
+					Color:       colorSuccess,
+					Title:       p.Sprintf("New Discussion Created"),
 					Author:      gh.Sender.AuthorEmbed(),
-					Description: gh.Discussion.AnswerRespBody,
+					Description: gh.Discussion.Body,
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Title",
@@ -274,11 +263,11 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -291,19 +280,19 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					URL:         gh.Repo.HTMLURL,
-					Color:       colorRed,
+					URL:         gh.Repository.HTMLURL,
+					Color:       colorFailure,
 					Author:      gh.Sender.AuthorEmbed(),
-					Title:       "Discussion Deleted",
+					Title:       p.Sprintf("Discussion Deleted"),
 					Description: descriptionString,
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -311,26 +300,24 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 	// Discussion has been edited
 	case "edited":
 
-		if len(gh.Discussion.AnswerRespBody) > 3000 {
-			gh.Discussion.AnswerRespBody = gh.Discussion.AnswerRespBody[:3000] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
-		}
+		gh.Discussion.Body = formatBody(p, gh.Discussion.Body, 3000, gh.Discussion.HTMLURL)
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					URL:         gh.Repo.HTMLURL,
-					Color:       colorYellow,
+					URL:         gh.Repository.HTMLURL,
+					Color:       colorWarning,
 					Author:      gh.Sender.AuthorEmbed(),
-					Title:       "Discussion Updated",
-					Description: gh.Discussion.AnswerRespBody,
+					Title:       p.Sprintf("Discussion Updated"),
+					Description: gh.Discussion.Body,
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 						{
@@ -339,7 +326,7 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -350,22 +337,22 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 		var defaultString string
 
 		if gh.Label.Default {
-			defaultString = "Yes"
+			defaultString = p.Sprintf("Yes")
 		} else {
-			defaultString = "No"
+			defaultString = p.Sprintf("No")
 		}
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					URL:    gh.Repo.HTMLURL,
-					Color:  colorGreen,
+					URL:    gh.Repository.HTMLURL,
+					Color:  colorInfo,
 					Author: gh.Sender.AuthorEmbed(),
-					Title:  "Discussion Label Added",
+					Title:  p.Sprintf("Discussion Label Added"),
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Discussion",
@@ -399,11 +386,11 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -414,25 +401,25 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 		var lockedReason string
 
 		if gh.Discussion.ActiveLockReason == "" {
-			lockedReason = "No reason provided"
+			lockedReason = p.Sprintf("No reason provided")
 		} else if len(gh.Discussion.ActiveLockReason) > 999 {
-			lockedReason = gh.Discussion.AnswerRespBody[:999] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			lockedReason = gh.Discussion.Body[:999] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		} else {
 			lockedReason = gh.Discussion.ActiveLockReason
 		}
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					URL:         gh.Repo.HTMLURL,
-					Color:       colorRed,
+					URL:         gh.Repository.HTMLURL,
+					Color:       colorWarning,
 					Author:      gh.Sender.AuthorEmbed(),
-					Title:       "Discussion Locked",
-					Description: "Adding new comments/answers is now prohibited",
+					Title:       p.Sprintf("Discussion Locked"),
+					Description: p.Sprintf("Adding new comments/answers is now prohibited"),
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Discussion",
@@ -451,11 +438,11 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -464,17 +451,17 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 	case "unlocked":
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					URL:         gh.Repo.HTMLURL,
-					Color:       colorRed,
+					URL:         gh.Repository.HTMLURL,
+					Color:       colorInfo,
 					Author:      gh.Sender.AuthorEmbed(),
-					Title:       "Discussion UnLocked",
-					Description: "You are free to answer/comment again",
+					Title:       p.Sprintf("Discussion UnLocked"),
+					Description: p.Sprintf("You are free to answer/comment again"),
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Discussion",
@@ -488,11 +475,11 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -500,22 +487,20 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 	// Discussion has been pinned
 	case "pinned":
 
-		if len(gh.Discussion.AnswerRespBody) > 3000 {
-			gh.Discussion.AnswerRespBody = gh.Discussion.AnswerRespBody[:3000] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
-		}
+		gh.Discussion.Body = formatBody(p, gh.Discussion.Body, 3000, gh.Discussion.HTMLURL)
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					URL:         gh.Repo.HTMLURL,
-					Color:       colorGreen,
+					URL:         gh.Repository.HTMLURL,
+					Color:       colorInfo,
 					Author:      gh.Sender.AuthorEmbed(),
-					Title:       "Discussion Pinned",
-					Description: gh.Discussion.AnswerRespBody,
+					Title:       p.Sprintf("Discussion Pinned"),
+					Description: gh.Discussion.Body,
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Discussion",
@@ -529,7 +514,7 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Author",
-							Value:  gh.Discussion.Author.Link(),
+							Value:  gh.Discussion.User.Link(),
 							Inline: true,
 						},
 						{
@@ -538,7 +523,7 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -546,22 +531,20 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 	// Case has been unpinned
 	case "unpinned":
 
-		if len(gh.Discussion.AnswerRespBody) > 3000 {
-			gh.Discussion.AnswerRespBody = gh.Discussion.AnswerRespBody[:3000] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
-		}
+		gh.Discussion.Body = formatBody(p, gh.Discussion.Body, 3000, gh.Discussion.HTMLURL)
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					URL:         gh.Repo.HTMLURL,
-					Color:       colorGreen,
+					URL:         gh.Repository.HTMLURL,
+					Color:       colorInfo,
 					Author:      gh.Sender.AuthorEmbed(),
-					Title:       "Discussion UnPinned",
-					Description: gh.Discussion.AnswerRespBody,
+					Title:       p.Sprintf("Discussion UnPinned"),
+					Description: gh.Discussion.Body,
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Discussion",
@@ -575,7 +558,7 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Author",
-							Value:  gh.Discussion.Author.Link(),
+							Value:  gh.Discussion.User.Link(),
 							Inline: true,
 						},
 						{
@@ -584,7 +567,7 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
@@ -593,21 +576,21 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 	default:
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.DiscussionURL + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					URL:         gh.Repo.HTMLURL,
-					Color:       colorRed,
+					URL:         gh.Repository.HTMLURL,
+					Color:       colorFailure,
 					Author:      gh.Sender.AuthorEmbed(),
-					Title:       "Discussion Updated",
-					Description: "It looks like this discussion has received a update that is not tracked by our systems yet!",
+					Title:       p.Sprintf("Discussion Updated"),
+					Description: p.Sprintf("It looks like this discussion has received a update that is not tracked by our systems yet!"),
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 						{
@@ -621,9 +604,24 @@ func discussionFn(bytes []byte) (*discordgo.MessageSend, error) {
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Discussion.DiscussionDate.Format(time.RFC3339),
+					Timestamp: gh.Discussion.CreatedAt,
 				},
 			},
 		}, nil
 	}
 }
+
+func discussionSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh DiscussionEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s discussion #%s: %s", gh.Sender.Login, translateAction(p, gh.Action), strconv.Itoa(gh.Discussion.Number), gh.Discussion.Title)
+
+	return summaryLine(gh.Repository.FullName, text, gh.Discussion.HTMLURL), nil
+}
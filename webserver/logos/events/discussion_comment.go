@@ -1,34 +1,11 @@
 package events
 
 import (
-	"time"
-
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type DiscussionCommentEvent struct {
-	Action  string     `json:"action"`     // The type of action for the event
-	Repo    Repository `json:"repository"` // The repository where this event was created
-	Sender  User       `json:"sender"`     // The user who sent/created the event
-	Comment struct {
-		Author  User      `json:"user"`       // Author of the comment
-		Content string    `json:"body"`       // The content of the comment
-		Created time.Time `json:"created_at"` // Comment creation
-		Url     string    `json:"html_url"`   // Url to the comment
-	} `json:"comment"`
-	Discussion struct {
-		Title    string `json:"title"` // The title of the origin discussion
-		Category struct {
-			Name        string `json:"name"`          // Name of the discussion category
-			Answerable  bool   `json:"is_answerable"` // If the discussion can have a valid answer
-			Description string `json:"description"`   // The description of the discussion category
-		} `json:"category"`
-		Date time.Time `json:"created_at"` // Date the discussion was created
-		Url  string    `json:"html_url"`   // URL to the discussion
-	} `json:"discussion"`
-}
-
-func discussionCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
+func discussionCommentFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh DiscussionCommentEvent
 
 	// Unmarshall the json into our struct
@@ -42,22 +19,20 @@ func discussionCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 
 	case "created":
 
-		if len(gh.Comment.Content) > 3000 {
-			gh.Comment.Content = gh.Comment.Content[:3000] + "... [Read More](" + gh.Comment.Url + ")"
-		}
+		gh.Comment.Body = formatBody(p, gh.Comment.Body, 3000, gh.Comment.HTMLURL)
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.Url + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					Color:       colorGreen,
-					URL:         gh.Repo.HTMLURL,
-					Title:       "New Comment on Discussion",
+					Color:       colorInfo,
+					URL:         gh.Repository.HTMLURL,
+					Title:       p.Sprintf("New Comment on Discussion"),
 					Author:      gh.Sender.AuthorEmbed(),
-					Description: gh.Comment.Content,
+					Description: gh.Comment.Body,
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Discussion",
@@ -71,33 +46,31 @@ func discussionCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Comment.Created.Format(time.RFC3339),
+					Timestamp: gh.Comment.CreatedAt,
 				},
 			},
 		}, nil
 
 	case "edited":
 
-		if len(gh.Comment.Content) > 3000 {
-			gh.Comment.Content = gh.Comment.Content[:3000] + "... [Read More](" + gh.Comment.Url + ")"
-		}
+		gh.Comment.Body = formatBody(p, gh.Comment.Body, 3000, gh.Comment.HTMLURL)
 
 		if len(gh.Discussion.Title) > 190 {
-			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.Url + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:190] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					Color:       colorGreen,
-					URL:         gh.Repo.HTMLURL,
-					Title:       "Discussion Comment Updated",
+					Color:       colorWarning,
+					URL:         gh.Repository.HTMLURL,
+					Title:       p.Sprintf("Discussion Comment Updated"),
 					Author:      gh.Sender.AuthorEmbed(),
-					Description: gh.Comment.Content,
+					Description: gh.Comment.Body,
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Discussion",
@@ -106,38 +79,36 @@ func discussionCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Comment Author",
-							Value:  gh.Comment.Author.Link(),
+							Value:  gh.Comment.User.Link(),
 							Inline: true,
 						},
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Comment.Created.Format(time.RFC3339),
+					Timestamp: gh.Comment.CreatedAt,
 				},
 			},
 		}, nil
 
 	case "deleted":
 
-		if len(gh.Comment.Content) > 3000 {
-			gh.Comment.Content = gh.Comment.Content[:3000] + "... [Read More](" + gh.Comment.Url + ")"
-		}
+		gh.Comment.Body = formatBody(p, gh.Comment.Body, 3000, gh.Comment.HTMLURL)
 
 		if len(gh.Discussion.Title) > 200 {
-			gh.Discussion.Title = gh.Discussion.Title[:200] + "... [View Discussion](" + gh.Discussion.Url + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:200] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					Color:       colorGreen,
-					URL:         gh.Repo.HTMLURL,
-					Title:       "Comment Deleted",
+					Color:       colorFailure,
+					URL:         gh.Repository.HTMLURL,
+					Title:       p.Sprintf("Comment Deleted"),
 					Author:      gh.Sender.AuthorEmbed(),
-					Description: gh.Comment.Content,
+					Description: gh.Comment.Body,
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Discussion",
@@ -146,12 +117,12 @@ func discussionCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Comment Author",
-							Value:  gh.Comment.Author.Link(),
+							Value:  gh.Comment.User.Link(),
 							Inline: true,
 						},
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 						{
@@ -160,7 +131,7 @@ func discussionCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Comment.Created.Format(time.RFC3339),
+					Timestamp: gh.Comment.CreatedAt,
 				},
 			},
 		}, nil
@@ -168,21 +139,21 @@ func discussionCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 	default:
 
 		if len(gh.Discussion.Title) > 200 {
-			gh.Discussion.Title = gh.Discussion.Title[:200] + "... [View Discussion](" + gh.Discussion.Url + ")"
+			gh.Discussion.Title = gh.Discussion.Title[:200] + "... [View Discussion](" + gh.Discussion.HTMLURL + ")"
 		}
 
 		return &discordgo.MessageSend{
 			Embeds: []*discordgo.MessageEmbed{
 				{
-					URL:         gh.Repo.HTMLURL,
-					Color:       colorRed,
+					URL:         gh.Repository.HTMLURL,
+					Color:       colorFailure,
 					Author:      gh.Sender.AuthorEmbed(),
-					Title:       "Discussion Comment Updated",
-					Description: "It looks like this comment has received a update that is not tracked by our systems yet!",
+					Title:       p.Sprintf("Discussion Comment Updated"),
+					Description: p.Sprintf("It looks like this comment has received a update that is not tracked by our systems yet!"),
 					Fields: []*discordgo.MessageEmbedField{
 						{
 							Name:   "Repository",
-							Value:  gh.Repo.FullName,
+							Value:  gh.Repository.FullName,
 							Inline: true,
 						},
 						{
@@ -192,13 +163,26 @@ func discussionCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 						},
 						{
 							Name:   "Comment Author",
-							Value:  gh.Comment.Author.Link(),
+							Value:  gh.Comment.User.Link(),
 							Inline: true,
 						},
 					},
-					Timestamp: gh.Comment.Created.Format(time.RFC3339),
+					Timestamp: gh.Comment.CreatedAt,
 				},
 			},
 		}, nil
 	}
 }
+
+func discussionCommentSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh DiscussionCommentEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, commentSummary(p, gh.Action, gh.Sender.Login, "\""+gh.Discussion.Title+"\"", gh.Comment.Body), gh.Comment.HTMLURL), nil
+}
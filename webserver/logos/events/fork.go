@@ -4,16 +4,10 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type ForkEvent struct {
-	Action string     `json:"action"`
-	Repo   Repository `json:"repository"`
-	Forkee Repository `json:"forkee"`
-	Sender User       `json:"sender"`
-}
-
-func forkFn(bytes []byte) (*discordgo.MessageSend, error) {
+func forkFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh ForkEvent
 
 	// Unmarshal the JSON into our struct
@@ -26,10 +20,10 @@ func forkFn(bytes []byte) (*discordgo.MessageSend, error) {
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:  colorGreen,
+				Color:  colorInfo,
 				URL:    gh.Forkee.HTMLURL,
 				Author: gh.Sender.AuthorEmbed(),
-				Title:  "New fork: " + gh.Forkee.FullName,
+				Title:  p.Sprintf("New fork: %s", gh.Forkee.FullName),
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:  "User",
@@ -37,7 +31,7 @@ func forkFn(bytes []byte) (*discordgo.MessageSend, error) {
 					},
 					{
 						Name:  "Original repo",
-						Value: gh.Repo.HTMLURL,
+						Value: gh.Repository.HTMLURL,
 					},
 					{
 						Name:  "Forked repo",
@@ -45,7 +39,7 @@ func forkFn(bytes []byte) (*discordgo.MessageSend, error) {
 					},
 					{
 						Name:  "Visibility",
-						Value: fmt.Sprintf("%s -> %s", gh.Repo.Visibility(), gh.Forkee.Visibility()),
+						Value: fmt.Sprintf("%s -> %s", gh.Repository.VisibilityLabel(), gh.Forkee.VisibilityLabel()),
 					},
 				},
 			},
@@ -53,3 +47,16 @@ func forkFn(bytes []byte) (*discordgo.MessageSend, error) {
 	}, nil
 
 }
+
+func forkSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh ForkEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, p.Sprintf("%s forked the repository to %s", gh.Sender.Login, gh.Forkee.FullName), gh.Forkee.HTMLURL), nil
+}
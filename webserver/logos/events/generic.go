@@ -0,0 +1,248 @@
+package events
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// genericFieldLimit is the maximum number of payload values shown by the generic renderer
+const genericFieldLimit = 6
+
+// Top-level keys that most payloads share, these are never the main object of an event
+var genericCommonKeys = map[string]bool{
+	"sender":       true,
+	"repository":   true,
+	"organization": true,
+	"installation": true,
+	"enterprise":   true,
+	"changes":      true,
+}
+
+// Keys of the main object that are shown in the title or description instead of as a field
+var (
+	genericTitleKeys = []string{"title", "name", "login"}
+	genericBodyKeys  = []string{"body", "description", "note"}
+)
+
+// genericString returns obj[key] if it is a non-empty string
+func genericString(obj map[string]any, key string) string {
+	s, _ := obj[key].(string)
+	return s
+}
+
+// genericFirst returns the first non-empty string of obj out of the given keys
+func genericFirst(obj map[string]any, keys []string) (string, string) {
+	for _, k := range keys {
+		if s := genericString(obj, k); s != "" {
+			return k, s
+		}
+	}
+
+	return "", ""
+}
+
+// genericMainObject finds the object an event is about, which is usually found under the
+// event name itself (such as "project_card" for project_card events)
+func genericMainObject(event string, payload map[string]any) map[string]any {
+	if obj, ok := payload[event].(map[string]any); ok {
+		return obj
+	}
+
+	var keys []string
+	for k, v := range payload {
+		if _, ok := v.(map[string]any); ok && !genericCommonKeys[k] {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	// Prefer an object that links to GitHub, which is what users will want to open
+	for _, k := range keys {
+		if genericString(payload[k].(map[string]any), "html_url") != "" {
+			return payload[k].(map[string]any)
+		}
+	}
+
+	if len(keys) > 0 {
+		return payload[keys[0]].(map[string]any)
+	}
+
+	return nil
+}
+
+// genericScalar formats a value that is useful to show as a field, returning false for
+// values that are not
+func genericScalar(key string, value any) (string, bool) {
+	if key == "id" || key == "node_id" || key == "url" || strings.HasSuffix(key, "_url") || strings.HasSuffix(key, "_at") || strings.HasSuffix(key, "_id") {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case string:
+		if v == "" || len(v) > 100 {
+			return "", false
+		}
+
+		return v, true
+	case bool:
+		return fmt.Sprint(v), true
+	case float64:
+		return fmt.Sprint(v), true
+	case map[string]any:
+		// Users such as the creator of an object are shown as a link
+		if login := genericString(v, "login"); login != "" {
+			return "[" + login + "](https://github.com/" + login + ")", true
+		}
+	}
+
+	return "", false
+}
+
+// Generic renders an event that has no renderer of its own. It picks out the parts most
+// payloads share and a few values of the object the event is about, and links to the
+// delivery log at auditURL (if set) instead of dumping the payload
+func Generic(event string, bytes []byte, auditURL string, p *message.Printer) (*discordgo.MessageSend, error) {
+	var payload map[string]any
+
+	// Unmarshal the JSON into our map
+	err := json.Unmarshal(bytes, &payload)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var caser = cases.Title(language.English)
+
+	// Events GitHub adds later on are not in the catalog and stay in English
+	var title = translate(p, caser.String(strings.ReplaceAll(event, "_", " ")))
+
+	if action := genericString(payload, "action"); action != "" {
+		title += " " + translateAction(p, action)
+	}
+
+	var url string
+
+	if repo, ok := payload["repository"].(map[string]any); ok && genericString(repo, "full_name") != "" {
+		title += p.Sprintf(" on %s", genericString(repo, "full_name"))
+		url = genericString(repo, "html_url")
+	} else if org, ok := payload["organization"].(map[string]any); ok && genericString(org, "login") != "" {
+		title += p.Sprintf(" in %s", genericString(org, "login"))
+		url = "https://github.com/" + genericString(org, "login")
+	}
+
+	var embed = &discordgo.MessageEmbed{
+		Color: colorWarning,
+		Title: title,
+	}
+
+	var fields []*discordgo.MessageEmbedField
+
+	addField := func(key, value string) {
+		if len(fields) >= genericFieldLimit {
+			return
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   caser.String(strings.ReplaceAll(key, "_", " ")),
+			Value:  value,
+			Inline: true,
+		})
+	}
+
+	// Top-level values such as the ref of create events
+	var keys []string
+	for k := range payload {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if k == "action" || genericCommonKeys[k] {
+			continue
+		}
+
+		if value, ok := genericScalar(k, payload[k]); ok {
+			addField(k, value)
+		}
+	}
+
+	if obj := genericMainObject(event, payload); obj != nil {
+		if htmlURL := genericString(obj, "html_url"); htmlURL != "" {
+			url = htmlURL
+		}
+
+		titleKey, name := genericFirst(obj, genericTitleKeys)
+		bodyKey, body := genericFirst(obj, genericBodyKeys)
+
+		if len(body) > 1000 {
+			body = cutAtBoundary(body, 1000) + "..."
+		}
+
+		if name != "" {
+			embed.Description = "**" + name + "**"
+
+			if body != "" {
+				embed.Description += "\n\n"
+			}
+		}
+
+		embed.Description += body
+
+		var objKeys []string
+		for k := range obj {
+			objKeys = append(objKeys, k)
+		}
+
+		sort.Strings(objKeys)
+
+		for _, k := range objKeys {
+			if k == titleKey || k == bodyKey {
+				continue
+			}
+
+			if value, ok := genericScalar(k, obj[k]); ok {
+				addField(k, value)
+			}
+		}
+	}
+
+	if url == "" {
+		url = genericString(payload, "html_url")
+	}
+
+	embed.URL = url
+
+	if sender, ok := payload["sender"].(map[string]any); ok && genericString(sender, "login") != "" {
+		embed.Author = &discordgo.MessageEmbedAuthor{
+			Name:    genericString(sender, "login"),
+			IconURL: genericString(sender, "avatar_url"),
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "User",
+			Value:  "[" + genericString(sender, "login") + "](" + genericString(sender, "html_url") + ")",
+			Inline: true,
+		})
+	}
+
+	if auditURL != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Payload",
+			Value: p.Sprintf("This event is not fully supported yet, [view the delivery log](%s)", auditURL),
+		})
+	}
+
+	embed.Fields = fields
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{embed},
+	}, nil
+}
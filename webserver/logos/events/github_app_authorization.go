@@ -0,0 +1,53 @@
+package events
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func githubAppAuthorizationFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh GithubAppAuthorizationEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	// Revoked is the only action GitHub sends for this event
+	var title = p.Sprintf("%s revoked their authorization of the GitHub App", gh.Sender.Login)
+	if gh.Action != "revoked" {
+		title = p.Sprintf("GitHub App authorization %s by %s", translateAction(p, gh.Action), gh.Sender.Login)
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  colorFailure,
+				URL:    gh.Sender.HTMLURL,
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  title,
+				Fields: []*discordgo.MessageEmbedField{
+					{
+						Name:  "User",
+						Value: gh.Sender.Link(),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func githubAppAuthorizationSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh GithubAppAuthorizationEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine("", p.Sprintf("%s %s their authorization of the GitHub App", gh.Sender.Login, translateAction(p, gh.Action)), gh.Sender.HTMLURL), nil
+}
@@ -0,0 +1,228 @@
+package events
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Run "go test ./logos/events -update" to regenerate the golden files after changing a renderer
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// The testdata directory holding sample payloads of events without a renderer
+const genericTestdata = "generic"
+
+// TestGolden renders every sample payload in testdata/<event>/*.json with the renderer for <event>
+// and compares the resulting MessageSend against the matching .golden file
+func TestGolden(t *testing.T) {
+	dirs, err := os.ReadDir("testdata")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+
+		event := dir.Name()
+
+		// Sample payloads for the generic renderer are checked by TestGenericGolden
+		if event == genericTestdata {
+			continue
+		}
+
+		evtFn, ok := SupportedEvents[event]
+
+		if !ok {
+			t.Errorf("testdata/%s exists but %s is not a supported event", event, event)
+			continue
+		}
+
+		payloads, err := filepath.Glob(filepath.Join("testdata", event, "*.json"))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, payload := range payloads {
+			payload := payload
+
+			t.Run(event+"/"+strings.TrimSuffix(filepath.Base(payload), ".json"), func(t *testing.T) {
+				checkGolden(t, payload, func(bytes []byte) (*discordgo.MessageSend, error) {
+					return evtFn(bytes, Printer("en"))
+				})
+			})
+		}
+	}
+}
+
+// TestGenericGolden renders every sample payload in testdata/generic/<event>.json with the
+// generic renderer used for events without one of their own
+func TestGenericGolden(t *testing.T) {
+	payloads, err := filepath.Glob(filepath.Join("testdata", genericTestdata, "*.json"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, payload := range payloads {
+		payload := payload
+		event := strings.TrimSuffix(filepath.Base(payload), ".json")
+
+		t.Run(event, func(t *testing.T) {
+			checkGolden(t, payload, func(bytes []byte) (*discordgo.MessageSend, error) {
+				return Generic(event, bytes, "https://v2.gitlogs.xyz/audit?log_id=test", Printer("en"))
+			})
+		})
+	}
+}
+
+// checkGolden renders payload and compares the resulting MessageSend against the matching .golden file
+func checkGolden(t *testing.T, payload string, evtFn func(bytes []byte) (*discordgo.MessageSend, error)) {
+	t.Helper()
+
+	body, err := os.ReadFile(payload)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messageSend, err := evtFn(body)
+
+	if err != nil {
+		t.Fatalf("renderer returned an error: %s", err)
+	}
+
+	checkEmbedLimits(t, messageSend)
+
+	got, err := json.MarshalIndent(messageSend, "", "  ")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got = append(got, '\n')
+
+	golden := strings.TrimSuffix(payload, ".json") + ".golden"
+
+	if *update {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(golden)
+
+	if err != nil {
+		t.Fatalf("could not read golden file, run with -update to create it: %s", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("rendered message does not match %s, run with -update if this is expected\n\ngot:\n%s\nwant:\n%s", golden, got, want)
+	}
+}
+
+// TestGoldenCoverage makes sure every supported event has at least one sample payload
+func TestGoldenCoverage(t *testing.T) {
+	for event := range SupportedEvents {
+		payloads, err := filepath.Glob(filepath.Join("testdata", event, "*.json"))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(payloads) == 0 {
+			t.Errorf("%s has no sample payloads in testdata/%s", event, event)
+		}
+	}
+}
+
+// TestEmptyPayload renders an empty payload with every renderer, which must not panic on
+// the missing fields
+func TestEmptyPayload(t *testing.T) {
+	for event, evtFn := range SupportedEvents {
+		event, evtFn := event, evtFn
+
+		t.Run(event, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("renderer panicked: %v", r)
+				}
+			}()
+
+			messageSend, err := evtFn([]byte("{}"), Printer("en"))
+
+			if err != nil {
+				t.Fatalf("renderer returned an error: %s", err)
+			}
+
+			checkEmbedLimits(t, messageSend)
+		})
+	}
+}
+
+// checkEmbedLimits reports every embed of the message that is over one of the Discord embed limits
+func checkEmbedLimits(t *testing.T, messageSend *discordgo.MessageSend) {
+	t.Helper()
+
+	if len(messageSend.Embeds) > 10 {
+		t.Errorf("message has %d embeds, the limit is 10", len(messageSend.Embeds))
+	}
+
+	for i, embed := range messageSend.Embeds {
+		total := len(embed.Title) + len(embed.Description)
+
+		if len(embed.Title) > EMBED_TITLE_LIMIT {
+			t.Errorf("embed %d: title is %d characters, the limit is %d", i, len(embed.Title), EMBED_TITLE_LIMIT)
+		}
+
+		if len(embed.Description) > EMBED_DESCRIPTION_LIMIT {
+			t.Errorf("embed %d: description is %d characters, the limit is %d", i, len(embed.Description), EMBED_DESCRIPTION_LIMIT)
+		}
+
+		if len(embed.Fields) > EMBED_FIELDS_MAX_COUNT {
+			t.Errorf("embed %d: has %d fields, the limit is %d", i, len(embed.Fields), EMBED_FIELDS_MAX_COUNT)
+		}
+
+		for _, field := range embed.Fields {
+			total += len(field.Name) + len(field.Value)
+
+			if len(field.Name) > EMBED_FIELD_NAME_LIMIT {
+				t.Errorf("embed %d: field %q name is %d characters, the limit is %d", i, field.Name, len(field.Name), EMBED_FIELD_NAME_LIMIT)
+			}
+
+			if len(field.Value) > EMBED_FIELD_VALUE_LIMIT {
+				t.Errorf("embed %d: field %q value is %d characters, the limit is %d", i, field.Name, len(field.Value), EMBED_FIELD_VALUE_LIMIT)
+			}
+		}
+
+		if embed.Footer != nil {
+			total += len(embed.Footer.Text)
+
+			if len(embed.Footer.Text) > EMBED_FOOTER_TEXT_LIMIT {
+				t.Errorf("embed %d: footer is %d characters, the limit is %d", i, len(embed.Footer.Text), EMBED_FOOTER_TEXT_LIMIT)
+			}
+		}
+
+		if embed.Author != nil {
+			total += len(embed.Author.Name)
+
+			if len(embed.Author.Name) > EMBED_AUTHOR_NAME_LIMIT {
+				t.Errorf("embed %d: author name is %d characters, the limit is %d", i, len(embed.Author.Name), EMBED_AUTHOR_NAME_LIMIT)
+			}
+		}
+
+		if total > EMBED_TOTAL_LIMIT {
+			t.Errorf("embed %d: is %d characters in total, the limit is %d", i, total, EMBED_TOTAL_LIMIT)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package events
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func gollumFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh GollumEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var color = colorSuccess
+	var pageList string
+	for i, page := range gh.Pages {
+		var emoji = "📄"
+		if page.Action == "edited" {
+			emoji = "📝"
+			color = colorWarning
+		}
+
+		line := fmt.Sprintf("%s [%s](%s) %s", emoji, page.Title, page.HTMLURL, page.Action)
+
+		if page.Action == "edited" && page.SHA != "" {
+			line += " ([diff](" + page.HTMLURL + "/_compare/" + page.SHA + "))"
+		}
+
+		if page.Summary != "" {
+			line += "\n> " + page.Summary
+		}
+
+		line += "\n"
+
+		if len(pageList)+len(line) > 3900 {
+			pageList += p.Sprintf("...and %d more", len(gh.Pages)-i)
+			break
+		}
+
+		pageList += line
+	}
+
+	var title = p.Sprintf("Wiki updated on %s", gh.Repository.FullName)
+	var url = gh.Repository.HTMLURL + "/wiki"
+
+	if len(gh.Pages) == 1 {
+		title = p.Sprintf("Wiki page %s %s on %s", gh.Pages[0].Title, translateAction(p, gh.Pages[0].Action), gh.Repository.FullName)
+		url = gh.Pages[0].HTMLURL
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:       color,
+				URL:         url,
+				Author:      gh.Sender.AuthorEmbed(),
+				Title:       title,
+				Description: pageList,
+				Fields: []*discordgo.MessageEmbedField{
+					{
+						Name:  "User",
+						Value: gh.Sender.Link(),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func gollumSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh GollumEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	// Without any pages, fall back to the title of the embed
+	if len(gh.Pages) == 0 {
+		return "", nil
+	}
+
+	var page = gh.Pages[0]
+	var text = p.Sprintf("%s %s wiki page %s", gh.Sender.Login, translateAction(p, page.Action), page.Title)
+
+	if len(gh.Pages) > 1 {
+		text += " " + p.Sprintf("(and %s more)", strconv.Itoa(len(gh.Pages)-1))
+	}
+
+	return summaryLine(gh.Repository.FullName, text, page.HTMLURL), nil
+}
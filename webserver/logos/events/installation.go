@@ -0,0 +1,154 @@
+package events
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+// permissions formats the permissions of the installation as a sorted list
+func (i Installation) permissions() string {
+	var perms []string
+	for k, v := range i.Permissions {
+		perms = append(perms, k+": "+v)
+	}
+
+	sort.Strings(perms)
+
+	return strings.Join(perms, "\n")
+}
+
+// repositoryList formats a list of installation repositories, linking to each of them
+func repositoryList(p *message.Printer, repos []InstallationRepository) string {
+	var list string
+	for i, repo := range repos {
+		line := "[" + repo.FullName + "](https://github.com/" + repo.FullName + ")"
+
+		if repo.Private {
+			line += p.Sprintf(" (private)")
+		}
+
+		line += "\n"
+
+		if len(list)+len(line) > 1000 {
+			list += p.Sprintf("...and %d more", len(repos)-i)
+			break
+		}
+
+		list += line
+	}
+
+	return list
+}
+
+func installationFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh InstallationEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var app = gh.Installation.AppSlug
+	if app == "" {
+		app = "GitHub App"
+	}
+
+	var color int
+	var title string
+	switch gh.Action {
+	case "created":
+		color = colorSuccess
+		title = p.Sprintf("%s installed on %s", app, gh.Installation.Account.Login)
+	case "deleted":
+		color = colorFailure
+		title = p.Sprintf("%s uninstalled from %s", app, gh.Installation.Account.Login)
+	case "suspend":
+		color = colorFailure
+		title = p.Sprintf("%s suspended on %s", app, gh.Installation.Account.Login)
+	case "unsuspend":
+		color = colorSuccess
+		title = p.Sprintf("%s unsuspended on %s", app, gh.Installation.Account.Login)
+	case "new_permissions_accepted":
+		color = colorWarning
+		title = p.Sprintf("New permissions accepted for %s on %s", app, gh.Installation.Account.Login)
+	default:
+		color = colorWarning
+		title = p.Sprintf("%s installation %s on %s", app, translateAction(p, gh.Action), gh.Installation.Account.Login)
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Account",
+			Value:  gh.Installation.Account.Link(),
+			Inline: true,
+		},
+		{
+			Name:   "Repository Selection",
+			Value:  gh.Installation.RepositorySelection,
+			Inline: true,
+		},
+		{
+			Name:   "User",
+			Value:  gh.Sender.Link(),
+			Inline: true,
+		},
+	}
+
+	if list := repositoryList(p, gh.Repositories); list != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Repositories",
+			Value: list,
+		})
+	}
+
+	if permissions := gh.Installation.permissions(); permissions != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Permissions",
+			Value:  permissions,
+			Inline: true,
+		})
+	}
+
+	if len(gh.Installation.Events) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Events",
+			Value:  strings.Join(gh.Installation.Events, "\n"),
+			Inline: true,
+		})
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  color,
+				URL:    gh.Installation.HTMLURL,
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  title,
+				Fields: fields,
+			},
+		},
+	}, nil
+}
+
+func installationSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh InstallationEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s a GitHub App on %s", gh.Sender.Login, translateAction(p, gh.Action), gh.Installation.Account.Login)
+	if gh.Installation.AppSlug != "" {
+		text = p.Sprintf("%s %s the GitHub App %s on %s", gh.Sender.Login, translateAction(p, gh.Action), gh.Installation.AppSlug, gh.Installation.Account.Login)
+	}
+
+	return summaryLine("", text, gh.Installation.HTMLURL), nil
+}
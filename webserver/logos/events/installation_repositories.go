@@ -0,0 +1,115 @@
+package events
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func installationRepositoriesFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh InstallationRepositoriesEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var app = gh.Installation.AppSlug
+	if app == "" {
+		app = "GitHub App"
+	}
+
+	repositories := func(count int) string {
+		if count == 1 {
+			return p.Sprintf("1 repository")
+		}
+
+		return p.Sprintf("%s repositories", strconv.Itoa(count))
+	}
+
+	var color int
+	var title string
+	if gh.Action == "removed" {
+		color = colorFailure
+		title = p.Sprintf("%s removed from %s on %s", repositories(len(gh.RepositoriesRemoved)), app, gh.Installation.Account.Login)
+	} else {
+		color = colorSuccess
+		title = p.Sprintf("%s added to %s on %s", repositories(len(gh.RepositoriesAdded)), app, gh.Installation.Account.Login)
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Account",
+			Value:  gh.Installation.Account.Link(),
+			Inline: true,
+		},
+		{
+			Name:   "Repository Selection",
+			Value:  gh.RepositorySelection,
+			Inline: true,
+		},
+		{
+			Name:   "User",
+			Value:  gh.Sender.Link(),
+			Inline: true,
+		},
+	}
+
+	if list := repositoryList(p, gh.RepositoriesAdded); list != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Added",
+			Value: list,
+		})
+	}
+
+	if list := repositoryList(p, gh.RepositoriesRemoved); list != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Removed",
+			Value: list,
+		})
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  color,
+				URL:    gh.Installation.HTMLURL,
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  title,
+				Fields: fields,
+			},
+		},
+	}, nil
+}
+
+func installationRepositoriesSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh InstallationRepositoriesEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var repos = gh.RepositoriesAdded
+	if gh.Action == "removed" {
+		repos = gh.RepositoriesRemoved
+	}
+
+	var names []string
+	for _, repo := range repos {
+		names = append(names, repo.FullName)
+	}
+
+	var text = p.Sprintf("%s %s repositories of a GitHub App: %s", gh.Sender.Login, translateAction(p, gh.Action), snippet(strings.Join(names, ", "), 150))
+	if gh.Installation.AppSlug != "" {
+		text = p.Sprintf("%s %s repositories of the GitHub App %s: %s", gh.Sender.Login, translateAction(p, gh.Action), gh.Installation.AppSlug, snippet(strings.Join(names, ", "), 150))
+	}
+
+	return summaryLine(gh.Installation.Account.Login, text, gh.Installation.HTMLURL), nil
+}
@@ -0,0 +1,371 @@
+// Command schemagen generates the payload types of events from the webhook JSON schemas in
+// logos/events/schema, run it with "go generate ./logos/events"
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Shared definitions in schema/common are generated as named types, so that renderers can add
+// methods to them in internal_common__.go and the event files. They are named after their file
+// unless listed here
+var refNames = map[string]string{
+	"common/pull_request_branch.schema.json":    "PullRequestCommit",
+	"common/commit.schema.json":                 "PushCommit",
+	"common/branch_protection_rule.schema.json": "bprRule",
+	"common/repository_ruleset.schema.json":     "repositoryRuleset",
+	"common/repository_rule.schema.json":        "rulesetRule",
+}
+
+// Shared definitions that are always referenced through a pointer, as their methods handle nil
+var refPointers = map[string]bool{
+	"common/change.schema.json": true,
+}
+
+// refName returns the Go name of the type generated for a shared definition
+func refName(ref string) (string, error) {
+	if name, ok := refNames[ref]; ok {
+		return name, nil
+	}
+
+	if !strings.HasPrefix(ref, "common/") || !strings.HasSuffix(ref, ".schema.json") {
+		return "", fmt.Errorf("unknown $ref %q", ref)
+	}
+
+	return goName(strings.TrimSuffix(strings.TrimPrefix(ref, "common/"), ".schema.json"))
+}
+
+// Parts of a property name that are written in upper case in Go
+var initialisms = map[string]bool{
+	"api":  true,
+	"cve":  true,
+	"cvss": true,
+	"cwe":  true,
+	"ghsa": true,
+	"html": true,
+	"id":   true,
+	"oid":  true,
+	"sha":  true,
+	"uri":  true,
+	"url":  true,
+}
+
+type schema struct {
+	Ref         string     `json:"$ref"`
+	Type        any        `json:"type"`
+	Description string     `json:"description"`
+	Properties  properties `json:"properties"`
+	Items       *schema    `json:"items"`
+	OneOf       []*schema  `json:"oneOf"`
+
+	// Additional properties are only generated when they are a schema, for objects keyed by
+	// name such as the changes of a branch protection rule
+	AdditionalProperties json.RawMessage `json:"additionalProperties"`
+}
+
+type property struct {
+	Name   string
+	Schema *schema
+}
+
+// properties keeps the properties of a schema in the order they are written in
+type properties []property
+
+func (p *properties) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		key, err := dec.Token()
+
+		if err != nil {
+			return err
+		}
+
+		var s schema
+		if err := dec.Decode(&s); err != nil {
+			return err
+		}
+
+		*p = append(*p, property{Name: key.(string), Schema: &s})
+	}
+
+	return nil
+}
+
+// types returns the JSON types of a schema other than null
+func (s *schema) types() []string {
+	switch t := s.Type.(type) {
+	case string:
+		return []string{t}
+	case []any:
+		var types []string
+		for _, v := range t {
+			if v, ok := v.(string); ok && v != "null" {
+				types = append(types, v)
+			}
+		}
+
+		return types
+	}
+
+	return nil
+}
+
+// Property names that are not snake case, such as the reaction counts of octokit's reactions
+var specialNames = map[string]string{
+	"+1": "PlusOne",
+	"-1": "MinusOne",
+}
+
+// goName converts a snake case property or event name to an exported Go name, leading
+// underscores (as in _links) are dropped
+func goName(name string) (string, error) {
+	if special, ok := specialNames[name]; ok {
+		return special, nil
+	}
+
+	var out string
+
+	for _, part := range strings.Split(strings.TrimLeft(name, "_"), "_") {
+		if part == "" {
+			return "", fmt.Errorf("cannot name property %q", name)
+		}
+
+		for _, r := range part {
+			if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9') {
+				return "", fmt.Errorf("cannot name property %q", name)
+			}
+		}
+
+		if initialisms[part] {
+			out += strings.ToUpper(part)
+		} else {
+			out += strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+
+	return out, nil
+}
+
+// goType returns the Go type of a schema, objects with properties become inline structs.
+// Values that can be of several types become any, and shared definitions that can be null
+// become pointers
+func goType(s *schema) (string, error) {
+	if len(s.OneOf) == 2 && s.OneOf[1].Type == "null" {
+		typ, err := goType(s.OneOf[0])
+
+		if err != nil || strings.HasPrefix(typ, "*") {
+			return typ, err
+		}
+
+		return "*" + typ, nil
+	}
+
+	if s.Ref != "" {
+		name, err := refName(s.Ref)
+
+		if err != nil || !refPointers[s.Ref] {
+			return name, err
+		}
+
+		return "*" + name, nil
+	}
+
+	types := s.types()
+
+	if s.Type == nil || len(types) > 1 {
+		return "any", nil
+	}
+
+	if len(types) == 0 || len(s.OneOf) > 0 {
+		return "", fmt.Errorf("unsupported type %v", s.Type)
+	}
+
+	switch types[0] {
+	case "string":
+		// Date-times are kept as strings (ISO 8601, which embeds take as is) so that a malformed
+		// timestamp in a part of the payload no renderer uses cannot fail the whole event
+		return "string", nil
+	case "integer":
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		if s.Items == nil {
+			return "[]any", nil
+		}
+
+		item, err := goType(s.Items)
+
+		if err != nil {
+			return "", err
+		}
+
+		return "[]" + item, nil
+	case "object":
+		if len(s.Properties) == 0 {
+			var additional schema
+			if json.Unmarshal(s.AdditionalProperties, &additional) != nil {
+				return "map[string]any", nil
+			}
+
+			value, err := goType(&additional)
+
+			if err != nil {
+				return "", err
+			}
+
+			return "map[string]" + value, nil
+		}
+
+		return goStruct(s)
+	}
+
+	return "", fmt.Errorf("unsupported type %q", types[0])
+}
+
+// goStruct returns a struct type with a field for every property of a schema
+func goStruct(s *schema) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("struct {\n")
+
+	for _, prop := range s.Properties {
+		name, err := goName(prop.Name)
+
+		if err != nil {
+			return "", err
+		}
+
+		typ, err := goType(prop.Schema)
+
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", prop.Name, err)
+		}
+
+		fmt.Fprintf(&b, "%s %s `json:%q`", name, typ, prop.Name)
+
+		if prop.Schema.Description != "" {
+			b.WriteString(" // " + prop.Schema.Description)
+		}
+
+		b.WriteString("\n")
+	}
+
+	b.WriteString("}")
+
+	return b.String(), nil
+}
+
+// readStruct reads a schema file and returns its struct type
+func readStruct(file string) (string, error) {
+	body, err := os.ReadFile(file)
+
+	if err != nil {
+		return "", err
+	}
+
+	var s schema
+	if err := json.Unmarshal(body, &s); err != nil {
+		return "", err
+	}
+
+	return goStruct(&s)
+}
+
+func main() {
+	in := flag.String("in", "schema", "directory holding the <event>.schema.json files")
+	out := flag.String("out", "payloads_gen.go", "file to write the generated types to")
+	flag.Parse()
+
+	files, err := filepath.Glob(filepath.Join(*in, "*.schema.json"))
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	common, err := filepath.Glob(filepath.Join(*in, "common", "*.schema.json"))
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	sort.Strings(files)
+	sort.Strings(common)
+
+	var types, shared, registry strings.Builder
+
+	for _, file := range files {
+		event := strings.TrimSuffix(filepath.Base(file), ".schema.json")
+
+		name, err := goName(event)
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, file+":", err)
+			os.Exit(1)
+		}
+
+		typ, err := readStruct(file)
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, file+":", err)
+			os.Exit(1)
+		}
+
+		fmt.Fprintf(&types, "// %sEvent is generated from %s\ntype %sEvent %s\n\n", name, filepath.ToSlash(file), name, typ)
+		fmt.Fprintf(&registry, "%q: %sEvent{},\n", event, name)
+	}
+
+	for _, file := range common {
+		name, err := refName("common/" + filepath.Base(file))
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, file+":", err)
+			os.Exit(1)
+		}
+
+		typ, err := readStruct(file)
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, file+":", err)
+			os.Exit(1)
+		}
+
+		fmt.Fprintf(&shared, "// %s is generated from %s\ntype %s %s\n\n", name, filepath.ToSlash(file), name, typ)
+	}
+
+	var src strings.Builder
+
+	src.WriteString("// Code generated by schemagen from schema/*.schema.json and schema/common/*.schema.json. DO NOT EDIT.\n\npackage events\n\n")
+	src.WriteString(types.String())
+	src.WriteString(shared.String())
+	src.WriteString("// generatedPayloads are the payload types generated from a schema, by event\nvar generatedPayloads = map[string]any{\n" + registry.String() + "}\n")
+
+	formatted, err := format.Source([]byte(src.String()))
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(*out, formatted, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,656 @@
+// Command schemavendor splits the octokit webhook schema vendored in logos/events/schema/octokit
+// into the layout schemagen reads: a schema per event in schema and a schema per shared
+// definition in schema/common. Run it with "make schemas" from the root of the repository, which
+// fetches the pinned version of the schema first
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// object is a JSON object that keeps its keys in the order they are written in, so that the
+// generated types list their fields in the same order as the schema
+type object struct {
+	keys   []string
+	values map[string]any
+}
+
+func newObject() *object {
+	return &object{values: map[string]any{}}
+}
+
+func (o *object) set(key string, value any) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+
+	o.values[key] = value
+}
+
+func (o *object) delete(key string) {
+	if _, ok := o.values[key]; !ok {
+		return
+	}
+
+	delete(o.values, key)
+
+	for i, k := range o.keys {
+		if k == key {
+			o.keys = append(o.keys[:i:i], o.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+
+	b.WriteByte('{')
+
+	for i, key := range o.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		k, err := json.Marshal(key)
+
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := json.Marshal(o.values[key])
+
+		if err != nil {
+			return nil, err
+		}
+
+		b.Write(k)
+		b.WriteByte(':')
+		b.Write(v)
+	}
+
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+// decode reads a JSON value, objects are read as *object
+func decode(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		o := newObject()
+
+		for dec.More() {
+			key, err := dec.Token()
+
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := decode(dec)
+
+			if err != nil {
+				return nil, err
+			}
+
+			o.set(key.(string), value)
+		}
+
+		_, err := dec.Token()
+		return o, err
+	case json.Delim('['):
+		var list = []any{}
+
+		for dec.More() {
+			value, err := decode(dec)
+
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, value)
+		}
+
+		_, err := dec.Token()
+		return list, err
+	}
+
+	return tok, nil
+}
+
+// splitter converts the definitions of the octokit schema, collecting the shared definitions the
+// events refer to
+type splitter struct {
+	definitions *object
+	version     string
+
+	// Shared definitions to write, by their name in the octokit schema
+	shared map[string]*object
+}
+
+// refPath returns where a shared definition of the octokit schema is written to, relative to
+// the schema directory
+func refPath(name string) string {
+	return "common/" + strings.ReplaceAll(name, "-", "_") + ".schema.json"
+}
+
+// convert rewrites a schema of the octokit schema into the subset of JSON schema schemagen
+// understands. $refs point to the files of schema/common, allOf is merged into one schema (or
+// the shared definition it extends), and a oneOf or anyOf that is not a nullable value is merged
+// if it only has objects and accepts any value otherwise
+func (s *splitter) convert(v any) (any, error) {
+	switch v := v.(type) {
+	case []any:
+		var list = make([]any, len(v))
+
+		for i, item := range v {
+			converted, err := s.convert(item)
+
+			if err != nil {
+				return nil, err
+			}
+
+			list[i] = converted
+		}
+
+		return list, nil
+	case *object:
+	default:
+		return v, nil
+	}
+
+	var o = v.(*object)
+
+	if ref, ok := o.values["$ref"].(string); ok {
+		name, ok := strings.CutPrefix(ref, "#/definitions/")
+
+		if !ok {
+			return nil, fmt.Errorf("unsupported $ref %q", ref)
+		}
+
+		if err := s.share(name); err != nil {
+			return nil, err
+		}
+
+		out := newObject()
+		out.set("$ref", refPath(name))
+
+		return out, nil
+	}
+
+	var out = newObject()
+
+	for _, key := range o.keys {
+		switch key {
+		case "allOf":
+			alternatives, err := s.convert(o.values[key])
+
+			if err != nil {
+				return nil, err
+			}
+
+			merged, err := mergeAll(alternatives.([]any), true)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, k := range merged.keys {
+				out.set(k, merged.values[k])
+			}
+		case "oneOf", "anyOf":
+			alternatives, err := s.convert(o.values[key])
+
+			if err != nil {
+				return nil, err
+			}
+
+			if nullable := nullableAlternative(alternatives.([]any)); nullable != nil {
+				out.set("oneOf", []any{nullable, typeNull()})
+				continue
+			}
+
+			if !allObjects(alternatives.([]any)) {
+				// Values of several types are left without a type, which schemagen maps to any
+				continue
+			}
+
+			merged, err := mergeAll(alternatives.([]any), false)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, k := range merged.keys {
+				out.set(k, merged.values[k])
+			}
+		case "$id", "$schema", "tsAdditionalProperties":
+		default:
+			converted, err := s.convert(o.values[key])
+
+			if err != nil {
+				return nil, err
+			}
+
+			out.set(key, converted)
+		}
+	}
+
+	return out, nil
+}
+
+// share converts a shared definition the first time it is referred to
+func (s *splitter) share(name string) error {
+	if _, ok := s.shared[name]; ok {
+		return nil
+	}
+
+	definition, ok := s.definitions.values[name].(*object)
+
+	if !ok {
+		return fmt.Errorf("no definition %q", name)
+	}
+
+	// Mark the definition first, shared definitions can refer to themselves
+	s.shared[name] = nil
+
+	converted, err := s.convert(definition)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	s.shared[name] = converted.(*object)
+
+	return nil
+}
+
+// mergeAll merges the converted alternatives of an allOf, oneOf or anyOf into one schema. An
+// allOf that extends a shared definition only narrows it down (such as a pull request that is
+// known to be merged), so it is replaced by the shared definition when extends is set
+func mergeAll(alternatives []any, extends bool) (*object, error) {
+	var merged *object
+
+	for _, alternative := range alternatives {
+		o, ok := alternative.(*object)
+
+		if !ok {
+			return nil, fmt.Errorf("unsupported alternative %v", alternative)
+		}
+
+		if _, ok := o.values["$ref"]; ok && extends {
+			return o, nil
+		}
+
+		if merged == nil {
+			merged = o
+		} else {
+			merged = merge(merged, o)
+		}
+	}
+
+	if merged == nil {
+		merged = newObject()
+	}
+
+	return merged, nil
+}
+
+// merge merges two schemas of the same value, such as the schemas of a property in two actions
+// of an event. Properties, types and enums are combined, and a property is only required if both
+// schemas require it
+func merge(a, b *object) *object {
+	if reflect.DeepEqual(a, b) {
+		return a
+	}
+
+	var out = newObject()
+
+	for _, key := range a.keys {
+		out.set(key, a.values[key])
+	}
+
+	for _, key := range b.keys {
+		bv := b.values[key]
+		av, ok := a.values[key]
+
+		if !ok {
+			if key != "required" {
+				out.set(key, bv)
+			}
+
+			continue
+		}
+
+		switch key {
+		case "properties":
+			ap, aok := av.(*object)
+			bp, bok := bv.(*object)
+
+			if !aok || !bok {
+				continue
+			}
+
+			properties := newObject()
+
+			for _, name := range ap.keys {
+				properties.set(name, ap.values[name])
+			}
+
+			for _, name := range bp.keys {
+				if existing, ok := properties.values[name].(*object); ok {
+					if other, ok := bp.values[name].(*object); ok {
+						properties.set(name, merge(existing, other))
+					}
+
+					continue
+				}
+
+				properties.set(name, bp.values[name])
+			}
+
+			out.set(key, properties)
+		case "items", "additionalProperties":
+			if ao, ok := av.(*object); ok {
+				if bo, ok := bv.(*object); ok {
+					out.set(key, merge(ao, bo))
+				}
+			}
+		case "type":
+			out.set(key, union(types(av), types(bv)))
+		case "enum":
+			out.set(key, union(av.([]any), bv.([]any)))
+		case "required":
+			var required []any
+			for _, name := range av.([]any) {
+				for _, other := range bv.([]any) {
+					if name == other {
+						required = append(required, name)
+					}
+				}
+			}
+
+			out.set(key, append([]any{}, required...))
+		}
+	}
+
+	// A property only one of the schemas requires is optional
+	if _, ok := b.values["required"]; !ok {
+		out.delete("required")
+	}
+
+	if len(types(out.values["type"])) == 1 {
+		out.set("type", types(out.values["type"])[0])
+	}
+
+	return out
+}
+
+// types returns the types of a schema as a list
+func types(v any) []any {
+	switch v := v.(type) {
+	case string:
+		return []any{v}
+	case []any:
+		return v
+	}
+
+	return nil
+}
+
+// union returns the values of a followed by the values of b that are not in a
+func union(a, b []any) []any {
+	var out = append([]any{}, a...)
+
+	for _, v := range b {
+		var found bool
+		for _, existing := range out {
+			if reflect.DeepEqual(v, existing) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+func typeNull() *object {
+	o := newObject()
+	o.set("type", "null")
+	return o
+}
+
+// nullableAlternative returns the alternative that is not null if a oneOf is a nullable value
+func nullableAlternative(alternatives []any) any {
+	if len(alternatives) != 2 {
+		return nil
+	}
+
+	for i, alternative := range alternatives {
+		if o, ok := alternative.(*object); ok && o.values["type"] == "null" && len(o.keys) == 1 {
+			return alternatives[1-i]
+		}
+	}
+
+	return nil
+}
+
+// allObjects returns whether every alternative of a oneOf is an object with properties
+func allObjects(alternatives []any) bool {
+	for _, alternative := range alternatives {
+		o, ok := alternative.(*object)
+
+		if !ok {
+			return false
+		}
+
+		if _, ok := o.values["properties"]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// event converts the schema of an event. octokit has a definition per action of an event
+// ("issues$opened") and one for the event that is a oneOf of them ("issues_event"), the actions
+// are merged into one schema
+func (s *splitter) event(name string) (*object, error) {
+	definition, ok := s.definitions.values[name+"_event"].(*object)
+
+	if !ok {
+		return nil, fmt.Errorf("no definition %q", name+"_event")
+	}
+
+	var schema *object
+
+	if actions, ok := definition.values["oneOf"].([]any); ok {
+		for _, action := range actions {
+			ref, _ := action.(*object).values["$ref"].(string)
+
+			actionName, ok := strings.CutPrefix(ref, "#/definitions/")
+
+			if !ok {
+				return nil, fmt.Errorf("unsupported action %v", action)
+			}
+
+			actionDefinition, ok := s.definitions.values[actionName].(*object)
+
+			if !ok {
+				return nil, fmt.Errorf("no definition %q", actionName)
+			}
+
+			converted, err := s.convert(actionDefinition)
+
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", actionName, err)
+			}
+
+			if schema == nil {
+				schema = converted.(*object)
+			} else {
+				schema = merge(schema, converted.(*object))
+			}
+		}
+	} else {
+		converted, err := s.convert(definition)
+
+		if err != nil {
+			return nil, err
+		}
+
+		schema = converted.(*object)
+	}
+
+	return schema, nil
+}
+
+// write writes a schema with the header of the files in the schema directory
+func (s *splitter) write(dir, path, title string, schema *object) error {
+	var out = newObject()
+
+	out.set("$schema", "http://json-schema.org/draft-07/schema")
+	out.set("$id", path)
+	out.set("$comment", "Split from @octokit/webhooks-schemas "+s.version+" (schema/octokit) by schemavendor, do not edit")
+	out.set("title", title)
+
+	for _, key := range schema.keys {
+		if key != "title" {
+			out.set(key, schema.values[key])
+		}
+	}
+
+	body, err := json.Marshal(out)
+
+	if err != nil {
+		return err
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, body, "", "  "); err != nil {
+		return err
+	}
+
+	indented.WriteByte('\n')
+
+	return os.WriteFile(filepath.Join(dir, path), indented.Bytes(), 0644)
+}
+
+func main() {
+	in := flag.String("in", "schema/octokit", "directory holding the vendored schema.json and its VERSION")
+	out := flag.String("out", "schema", "directory to write the <event>.schema.json files to")
+	flag.Parse()
+
+	if err := run(*in, *out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run splits the schema in the in directory into the out directory. The events are the ones
+// that already have a schema in out
+func run(in, out string) error {
+	body, err := os.ReadFile(filepath.Join(in, "schema.json"))
+
+	if err != nil {
+		return err
+	}
+
+	version, err := os.ReadFile(filepath.Join(in, "VERSION"))
+
+	if err != nil {
+		return err
+	}
+
+	doc, err := decode(json.NewDecoder(bytes.NewReader(body)))
+
+	if err != nil {
+		return fmt.Errorf("schema.json: %w", err)
+	}
+
+	root, ok := doc.(*object)
+
+	if !ok {
+		return fmt.Errorf("schema.json: not an object")
+	}
+
+	definitions, ok := root.values["definitions"].(*object)
+
+	if !ok {
+		return fmt.Errorf("schema.json: no definitions")
+	}
+
+	s := &splitter{
+		definitions: definitions,
+		version:     strings.TrimSpace(string(version)),
+		shared:      map[string]*object{},
+	}
+
+	files, err := filepath.Glob(filepath.Join(out, "*.schema.json"))
+
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		event := strings.TrimSuffix(filepath.Base(file), ".schema.json")
+
+		schema, err := s.event(event)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", event, err)
+		}
+
+		if err := s.write(out, event+".schema.json", event+" event", schema); err != nil {
+			return err
+		}
+	}
+
+	// The shared definitions are rewritten from scratch, so that definitions no event refers to
+	// anymore are removed
+	common, err := filepath.Glob(filepath.Join(out, "common", "*.schema.json"))
+
+	if err != nil {
+		return err
+	}
+
+	for _, file := range common {
+		if err := os.Remove(file); err != nil {
+			return err
+		}
+	}
+
+	var names []string
+	for name := range s.shared {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := s.write(out, refPath(name), name, s.shared[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
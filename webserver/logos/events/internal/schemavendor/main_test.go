@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// octokitSample has the shape of the octokit schema: a definition per action of an event, one for
+// the event that is a oneOf of them, and shared definitions used through $refs
+const octokitSample = `{
+  "definitions": {
+    "issues$opened": {
+      "$id": "issues$opened",
+      "type": "object",
+      "required": ["action", "issue", "sender"],
+      "properties": {
+        "action": { "type": "string", "enum": ["opened"] },
+        "issue": { "$ref": "#/definitions/issue" },
+        "sender": { "$ref": "#/definitions/user" }
+      },
+      "additionalProperties": false
+    },
+    "issues$closed": {
+      "$id": "issues$closed",
+      "type": "object",
+      "required": ["action", "issue"],
+      "properties": {
+        "action": { "type": "string", "enum": ["closed"] },
+        "issue": {
+          "allOf": [
+            { "$ref": "#/definitions/issue" },
+            { "type": "object", "properties": { "state": { "type": "string", "enum": ["closed"] } } }
+          ]
+        },
+        "sender": { "$ref": "#/definitions/user" },
+        "assignee": { "oneOf": [{ "$ref": "#/definitions/user" }, { "type": "null" }] }
+      },
+      "additionalProperties": false
+    },
+    "issues_event": {
+      "oneOf": [{ "$ref": "#/definitions/issues$opened" }, { "$ref": "#/definitions/issues$closed" }]
+    },
+    "issue": {
+      "$id": "issue",
+      "type": "object",
+      "properties": {
+        "number": { "type": "integer" },
+        "state": { "type": "string", "enum": ["open", "closed"] },
+        "user": { "$ref": "#/definitions/user" },
+        "reactions": { "$ref": "#/definitions/reactions" }
+      }
+    },
+    "reactions": {
+      "type": "object",
+      "properties": { "+1": { "type": "integer" }, "-1": { "type": "integer" } }
+    },
+    "user": {
+      "$id": "user",
+      "type": "object",
+      "properties": {
+        "login": { "type": "string" },
+        "name": { "anyOf": [{ "type": "string" }, { "type": "integer" }] }
+      }
+    },
+    "unused": { "type": "object", "properties": {} }
+  }
+}`
+
+// readSplit reads a file written by run
+func readSplit(t *testing.T, dir, name string) map[string]any {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join(dir, name))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schema map[string]any
+	if err := json.Unmarshal(body, &schema); err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+
+	return schema
+}
+
+func TestRun(t *testing.T) {
+	in, out := t.TempDir(), t.TempDir()
+
+	if err := os.Mkdir(filepath.Join(out, "common"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, body := range map[string]string{
+		filepath.Join(in, "schema.json"):                  octokitSample,
+		filepath.Join(in, "VERSION"):                      "1.2.3\n",
+		filepath.Join(out, "issues.schema.json"):          "{}",
+		filepath.Join(out, "common", "stale.schema.json"): "{}",
+	} {
+		if err := os.WriteFile(name, []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := run(in, out); err != nil {
+		t.Fatal(err)
+	}
+
+	issues := readSplit(t, out, "issues.schema.json")
+	properties := issues["properties"].(map[string]any)
+
+	if got, want := properties["action"].(map[string]any)["enum"], []any{"opened", "closed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("action enum: got %v, want %v", got, want)
+	}
+
+	// Only the properties every action has are required
+	if got, want := issues["required"], []any{"action", "issue"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("required: got %v, want %v", got, want)
+	}
+
+	// An allOf narrowing down a shared definition is the shared definition
+	if got, want := properties["issue"], map[string]any{"$ref": "common/issue.schema.json"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("issue: got %v, want %v", got, want)
+	}
+
+	if got, want := properties["assignee"], map[string]any{"oneOf": []any{map[string]any{"$ref": "common/user.schema.json"}, map[string]any{"type": "null"}}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("assignee: got %v, want %v", got, want)
+	}
+
+	if got := issues["$comment"]; got != "Split from @octokit/webhooks-schemas 1.2.3 (schema/octokit) by schemavendor, do not edit" {
+		t.Errorf("$comment: got %v", got)
+	}
+
+	// Values of several types are left without a type
+	user := readSplit(t, out, "common/user.schema.json")
+	if got := user["properties"].(map[string]any)["name"]; !reflect.DeepEqual(got, map[string]any{}) {
+		t.Errorf("user name: got %v, want no type", got)
+	}
+
+	files, err := filepath.Glob(filepath.Join(out, "common", "*.schema.json"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, file := range files {
+		names = append(names, filepath.Base(file))
+	}
+
+	if want := []string{"issue.schema.json", "reactions.schema.json", "user.schema.json"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("shared definitions: got %v, want %v", names, want)
+	}
+}
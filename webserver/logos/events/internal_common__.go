@@ -3,57 +3,100 @@ package events
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 	jsoniter "github.com/json-iterator/go"
+	"golang.org/x/text/message"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
 
-var (
-	colorGreen   = 0x00ff1a
-	colorYellow  = 0xffff00
-	colorRed     = 0xff0000
-	colorDarkRed = 0x8b0000
-)
+// The payload types of every supported event are generated from schema/ into payloads_gen.go
+//go:generate go run ./internal/schemagen -in schema -out payloads_gen.go
+
+// severityColor returns the color for an open security alert of the given severity. High and
+// critical alerts get the severe color, anything else is shown as a failure. Alerts that were
+// fixed, dismissed or resolved keep the color of their action instead
+func severityColor(severity string) int {
+	switch strings.ToLower(severity) {
+	case "high", "critical":
+		return colorSevere
+	}
 
-var SupportedEvents = map[string]func(bytes []byte) (*discordgo.MessageSend, error){
-	"branch_protection_rule":      branchProtectionRuleFn,
-	"check_suite":                 checkSuiteFn,
-	"create":                      createFn,
-	"issues":                      issuesFn,
-	"issue_comment":               issueCommentFn,
-	"pull_request":                pullRequestFn,
-	"pull_request_review_comment": pullRequestReviewCommentFn,
-	"push":                        pushFn,
-	"star":                        starFn,
-	"status":                      statusFn,
-	"release":                     releaseFn,
-	"commit_comment":              commitCommentFn,
-	"deployment":                  deploymentFn,
-	"deployment_status":           deploymentStatusFn,
-	"discussion":                  discussionFn,
-	"discussion_comment":          discussionCommentFn,
-	"workflow_run":                workflowRunFn,
-	"dependabot_alert":            dependabotAlertFn,
-	"delete":                      deleteFn,
-	"workflow_job":                workflowJobFn,
-	"check_run":                   checkRunFn,
-	"public":                      publicFn,
-	"watch":                       watchFn,
-	"repository":                  repositoryFn,
-	"team":                        teamFn,
-	"fork":                        forkFn,
-	"page_build":                  pageBuildFn,
-}
-
-type User struct {
-	Login            string `json:"login"`
-	ID               int    `json:"id"`
-	AvatarURL        string `json:"avatar_url"`
-	URL              string `json:"url"`
-	HTMLURL          string `json:"html_url"`
-	OrganizationsURL string `json:"organizations_url"`
+	return colorFailure
+}
+
+// conclusionColor returns the color for a workflow run, workflow job or check of the
+// given status and conclusion
+func conclusionColor(status, conclusion string) int {
+	if status != "completed" {
+		return colorWarning
+	}
+
+	switch conclusion {
+	case "success":
+		return colorSuccess
+	case "failure", "timed_out", "startup_failure":
+		return colorFailure
+	case "action_required":
+		return colorWarning
+	default:
+		// cancelled, skipped, neutral and stale runs did not pass or fail
+		return colorNeutral
+	}
+}
+
+var SupportedEvents = map[string]func(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error){
+	"branch_protection_rule":         branchProtectionRuleFn,
+	"check_suite":                    checkSuiteFn,
+	"create":                         createFn,
+	"issues":                         issuesFn,
+	"issue_comment":                  issueCommentFn,
+	"pull_request":                   pullRequestFn,
+	"pull_request_review":            pullRequestReviewFn,
+	"pull_request_review_comment":    pullRequestReviewCommentFn,
+	"push":                           pushFn,
+	"star":                           starFn,
+	"status":                         statusFn,
+	"release":                        releaseFn,
+	"commit_comment":                 commitCommentFn,
+	"deployment":                     deploymentFn,
+	"deployment_status":              deploymentStatusFn,
+	"discussion":                     discussionFn,
+	"discussion_comment":             discussionCommentFn,
+	"workflow_run":                   workflowRunFn,
+	"dependabot_alert":               dependabotAlertFn,
+	"delete":                         deleteFn,
+	"workflow_job":                   workflowJobFn,
+	"check_run":                      checkRunFn,
+	"public":                         publicFn,
+	"watch":                          watchFn,
+	"repository":                     repositoryFn,
+	"team":                           teamFn,
+	"fork":                           forkFn,
+	"page_build":                     pageBuildFn,
+	"repository_vulnerability_alert": repositoryVulnerabilityAlertFn,
+	"code_scanning_alert":            codeScanningAlertFn,
+	"secret_scanning_alert":          secretScanningAlertFn,
+	"label":                          labelFn,
+	"milestone":                      milestoneFn,
+	"member":                         memberFn,
+	"membership":                     membershipFn,
+	"package":                        packageFn,
+	"registry_package":               registryPackageFn,
+	"merge_group":                    mergeGroupFn,
+	"repository_ruleset":             repositoryRulesetFn,
+	"gollum":                         gollumFn,
+	"sponsorship":                    sponsorshipFn,
+	"security_advisory":              securityAdvisoryFn,
+	"installation":                   installationFn,
+	"installation_repositories":      installationRepositoriesFn,
+	"github_app_authorization":       githubAppAuthorizationFn,
+	"organization":                   organizationFn,
+	"org_block":                      orgBlockFn,
+	"project":                        projectFn,
+	"projects_v2_item":               projectsV2ItemFn,
 }
 
 func (u User) AuthorEmbed() *discordgo.MessageEmbedAuthor {
@@ -67,68 +110,96 @@ func (u User) Link() string {
 	return "[" + strings.ReplaceAll(u.Login, " ", "%20") + "](" + u.HTMLURL + ")"
 }
 
-type Repository struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	FullName    string `json:"full_name"`
-	Description string `json:"description"`
-	URL         string `json:"url"`
-	Owner       User   `json:"owner"`
-	HTMLURL     string `json:"html_url"`
-	CommitsURL  string `json:"commits_url"`
-	Private     bool   `json:"private"`
+func (o Organization) Link() string {
+	return "[" + o.Login + "](https://github.com/" + o.Login + ")"
 }
 
 // Commit returns the commit URL for the given commit ID.
 func (r Repository) Commit(id string) string {
-	return "[" + id[:7] + "](" + r.HTMLURL + "/commit/" + id + ")"
+	return "[" + shortSHA(id) + "](" + r.HTMLURL + "/commit/" + id + ")"
+}
+
+// shortSHA returns the abbreviated form of a commit SHA, short or missing SHAs are returned as is
+func shortSHA(id string) string {
+	if len(id) > 7 {
+		return id[:7]
+	}
+
+	return id
 }
 
-func (r Repository) Visibility() string {
+func (r Repository) VisibilityLabel() string {
 	if r.Private {
 		return "Private"
 	}
 	return "Public"
 }
 
-type Issue struct {
-	ID      int    `json:"id"`
-	Number  int    `json:"number"`
-	State   string `json:"state"`
-	Title   string `json:"title"`
-	Body    string `json:"body"`
-	HTMLURL string `json:"html_url"`
-	URL     string `json:"url"`
-	User    User   `json:"user"`
-}
-
-type PullRequestCommit struct {
-	Repo       Repository `json:"repo"`
-	ID         int        `json:"id"`
-	Number     int        `json:"number"`
-	State      string     `json:"state"`
-	Title      string     `json:"title"`
-	Body       string     `json:"body"`
-	HTMLURL    string     `json:"html_url"`
-	URL        string     `json:"url"`
-	Ref        string     `json:"ref"`
-	Label      string     `json:"label"`
-	User       User       `json:"user"`
-	CommitsURL string     `json:"commits_url"`
-}
-
-type PullRequest struct {
-	ID      int               `json:"id"`
-	Number  int               `json:"number"`
-	State   string            `json:"state"`
-	Locked  bool              `json:"locked"`
-	Title   string            `json:"title"`
-	Body    string            `json:"body"`
-	HTMLURL string            `json:"html_url"`
-	URL     string            `json:"url"`
-	User    User              `json:"user"`
-	Base    PullRequestCommit `json:"base"`
-	Head    PullRequestCommit `json:"head"`
+// formatDate formats a date as a Discord timestamp, falling back to the raw value if it
+// cannot be parsed
+func formatDate(date string) string {
+	if date == "" {
+		return ""
+	}
+
+	t, err := time.Parse(time.RFC3339, date)
+
+	if err != nil {
+		return date
+	}
+
+	return fmt.Sprintf("<t:%d:D>", t.Unix())
+}
+
+// formatDuration returns the time between two RFC3339 timestamps such as "2m 5s", or an
+// empty string if either cannot be parsed
+func formatDuration(start, end string) string {
+	startTime, err := time.Parse(time.RFC3339, start)
+
+	if err != nil {
+		return ""
+	}
+
+	endTime, err := time.Parse(time.RFC3339, end)
+
+	if err != nil || endTime.Before(startTime) {
+		return ""
+	}
+
+	d := endTime.Sub(startTime).Round(time.Second)
+
+	switch {
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh %dm %ds", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm %ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+}
+
+// Line formats the change as "**name:** old → new", using current when GitHub did not send
+// the new value. Returns an empty string if nothing changed
+func (c *Change) Line(p *message.Printer, name, current string) string {
+	if c == nil {
+		return ""
+	}
+
+	var to = c.To
+	if to == "" {
+		to = current
+	}
+
+	var from = c.From
+	if from == "" {
+		from = p.Sprintf("*none*")
+	}
+
+	if to == "" {
+		to = p.Sprintf("*none*")
+	}
+
+	return label(p, name) + " " + from + " → " + to + "\n"
 }
 
 // Auxillary but useful for large lists of data
@@ -147,6 +218,17 @@ func (k KeyValue) StringMD() string {
 
 // Core struct for defining a basic github event
 type RepoWrapper struct {
-	Repo   Repository `json:"repository"`
-	Action string     `json:"action"`
+	Repo   Repository   `json:"repository"`
+	Org    Organization `json:"organization"`
+	Action string       `json:"action"`
+}
+
+// RouteName returns the name an event is matched against in repos, this is the repository
+// name or the organization login for organization-level events without a repository
+func (rw RepoWrapper) RouteName() string {
+	if rw.Repo.FullName != "" {
+		return rw.Repo.FullName
+	}
+
+	return rw.Org.Login
 }
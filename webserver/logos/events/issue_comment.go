@@ -1,24 +1,13 @@
 package events
 
 import (
-	"fmt"
+	"strconv"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type IssueCommentEvent struct {
-	Action  string     `json:"action"`
-	Repo    Repository `json:"repository"`
-	Sender  User       `json:"sender"`
-	Issue   Issue      `json:"issue"`
-	Comment struct {
-		Body    string `json:"body"`
-		HTMLURL string `json:"html_url"`
-		User    User   `json:"user"`
-	} `json:"comment"`
-}
-
-func issueCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
+func issueCommentFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh IssueCommentEvent
 
 	// Unmarshal the JSON into our struct
@@ -28,30 +17,26 @@ func issueCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
-	var body string = gh.Issue.Body
-	if len(gh.Issue.Body) > 1000 {
-		body = gh.Issue.Body[:1000] + "..."
-	}
+	var body = formatBody(p, gh.Issue.Body, 1000, gh.Issue.HTMLURL)
 
 	if body == "" {
-		body = "No description available"
+		body = p.Sprintf("No description available")
 	}
 
-	var comment string = gh.Comment.Body
-
-	if len(gh.Comment.Body) > 1000 {
-		comment = gh.Comment.Body[:1000] + "..."
-	}
+	var comment = formatBody(p, gh.Comment.Body, 1000, gh.Comment.HTMLURL)
 
 	if comment == "" {
-		comment = "No description available"
+		comment = p.Sprintf("No description available")
 	}
 
 	var color int
-	if gh.Action == "deleted" {
-		color = colorRed
-	} else {
-		color = colorGreen
+	switch gh.Action {
+	case "deleted":
+		color = colorFailure
+	case "edited":
+		color = colorWarning
+	default:
+		color = colorInfo
 	}
 
 	return &discordgo.MessageSend{
@@ -60,7 +45,7 @@ func issueCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 				Color:  color,
 				URL:    gh.Issue.HTMLURL,
 				Author: gh.Sender.AuthorEmbed(),
-				Title:  fmt.Sprintf("Comment on %s (#%d) %s", gh.Repo.FullName, gh.Issue.Number, gh.Action),
+				Title:  p.Sprintf("Comment on %s (#%s) %s", gh.Repository.FullName, strconv.Itoa(gh.Issue.Number), translateAction(p, gh.Action)),
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:  "User",
@@ -83,3 +68,16 @@ func issueCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func issueCommentSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh IssueCommentEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, commentSummary(p, gh.Action, gh.Sender.Login, "#"+strconv.Itoa(gh.Issue.Number), gh.Comment.Body), gh.Comment.HTMLURL), nil
+}
@@ -1,19 +1,13 @@
 package events
 
 import (
-	"fmt"
+	"strconv"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type IssuesEvent struct {
-	Action string     `json:"action"`
-	Repo   Repository `json:"repository"`
-	Sender User       `json:"sender"`
-	Issue  Issue      `json:"issue"`
-}
-
-func issuesFn(bytes []byte) (*discordgo.MessageSend, error) {
+func issuesFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh IssuesEvent
 
 	// Unmarshal the JSON into our struct
@@ -23,20 +17,22 @@ func issuesFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
-	var body string = gh.Issue.Body
-	if len(gh.Issue.Body) > 996 {
-		body = gh.Issue.Body[:996] + "..."
-	}
+	var body = formatBody(p, gh.Issue.Body, 1000, gh.Issue.HTMLURL)
 
 	if body == "" {
-		body = "No description available"
+		body = p.Sprintf("No description available")
 	}
 
 	var color int
-	if gh.Action == "deleted" || gh.Action == "unpinned" {
-		color = colorRed
-	} else {
-		color = colorGreen
+	switch gh.Action {
+	case "opened", "reopened":
+		color = colorSuccess
+	case "closed":
+		color = colorNeutral
+	case "deleted":
+		color = colorFailure
+	default:
+		color = colorInfo
 	}
 
 	return &discordgo.MessageSend{
@@ -46,7 +42,7 @@ func issuesFn(bytes []byte) (*discordgo.MessageSend, error) {
 				URL:         gh.Issue.HTMLURL,
 				Author:      gh.Sender.AuthorEmbed(),
 				Description: body,
-				Title:       fmt.Sprintf("Issue %s on %s (#%d)", gh.Action, gh.Repo.FullName, gh.Issue.Number),
+				Title:       p.Sprintf("Issue %s on %s (#%s)", translateAction(p, gh.Action), gh.Repository.FullName, strconv.Itoa(gh.Issue.Number)),
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:   "Action",
@@ -68,3 +64,18 @@ func issuesFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func issuesSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh IssuesEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s issue #%s: %s", gh.Sender.Login, translateAction(p, gh.Action), strconv.Itoa(gh.Issue.Number), gh.Issue.Title)
+
+	return summaryLine(gh.Repository.FullName, text, gh.Issue.HTMLURL), nil
+}
@@ -0,0 +1,108 @@
+package events
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func labelFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh LabelEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	// Show labels in their own color where possible
+	var color = colorSuccess
+	if labelColor, err := strconv.ParseInt(gh.Label.Color, 16, 32); err == nil {
+		color = int(labelColor)
+	}
+
+	if gh.Action == "deleted" {
+		color = colorFailure
+	}
+
+	var description = gh.Label.Description
+	if len(description) > 1000 {
+		description = description[:1000] + "..."
+	}
+
+	if description == "" {
+		description = p.Sprintf("No description provided.")
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Name",
+			Value:  gh.Label.Name,
+			Inline: true,
+		},
+		{
+			Name:   "Color",
+			Value:  "#" + gh.Label.Color,
+			Inline: true,
+		},
+		{
+			Name:  "Description",
+			Value: description,
+		},
+	}
+
+	var colorChange = gh.Changes.Color
+	if colorChange != nil && colorChange.From != "" {
+		colorChange.From = "#" + colorChange.From
+	}
+
+	changes := gh.Changes.Name.Line(p, "Name", gh.Label.Name) +
+		colorChange.Line(p, "Color", "#"+gh.Label.Color) +
+		gh.Changes.Description.Line(p, "Description", gh.Label.Description)
+
+	if len(changes) > 1000 {
+		changes = changes[:1000] + "..."
+	}
+
+	if changes != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Changes",
+			Value: changes,
+		})
+	}
+
+	var labelURL = gh.Repository.HTMLURL + "/labels/" + url.PathEscape(gh.Label.Name)
+	if gh.Action == "deleted" {
+		labelURL = gh.Repository.HTMLURL + "/labels"
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  color,
+				URL:    labelURL,
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  p.Sprintf("Label %s %s on %s", gh.Label.Name, translateAction(p, gh.Action), gh.Repository.FullName),
+				Fields: fields,
+			},
+		},
+	}, nil
+}
+
+func labelSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh LabelEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s label %s", gh.Sender.Login, translateAction(p, gh.Action), gh.Label.Name)
+
+	return summaryLine(gh.Repository.FullName, text, gh.Repository.HTMLURL+"/labels"), nil
+}
@@ -0,0 +1,21 @@
+package events
+
+// Discord embed limits, renderers should stay within these so that pneuma never has to truncate
+const (
+	// EMBED_TITLE_LIMIT is the maximum length of an embed title
+	EMBED_TITLE_LIMIT = 256
+	// EMBED_DESCRIPTION_LIMIT is the maximum length of an embed description
+	EMBED_DESCRIPTION_LIMIT = 4096
+	// EMBED_FIELDS_MAX_COUNT is the maximum number of fields in an embed
+	EMBED_FIELDS_MAX_COUNT = 25
+	// EMBED_FIELD_NAME_LIMIT is the maximum length of an embed field name
+	EMBED_FIELD_NAME_LIMIT = 256
+	// EMBED_FIELD_VALUE_LIMIT is the maximum length of an embed field value
+	EMBED_FIELD_VALUE_LIMIT = 1024
+	// EMBED_FOOTER_TEXT_LIMIT is the maximum length of an embed footer text
+	EMBED_FOOTER_TEXT_LIMIT = 2048
+	// EMBED_AUTHOR_NAME_LIMIT is the maximum length of an embed author name
+	EMBED_AUTHOR_NAME_LIMIT = 256
+	// EMBED_TOTAL_LIMIT is the maximum length of an embed
+	EMBED_TOTAL_LIMIT = 6000
+)
@@ -0,0 +1,89 @@
+package events
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// Locales are the languages messages can be rendered in. English is the default, and is
+// used for any string that is missing from the catalog of another locale
+var Locales = []language.Tag{
+	language.English,
+	language.German,
+	language.Portuguese,
+}
+
+var localeMatcher = language.NewMatcher(Locales)
+
+// The message catalog, keyed by the English string (or format string) a renderer uses
+var messages = catalog.NewBuilder(catalog.Fallback(language.English))
+
+func init() {
+	for tag, entries := range map[language.Tag]map[string]string{
+		language.German:     messagesDE,
+		language.Portuguese: messagesPT,
+	} {
+		for key, msg := range entries {
+			if err := messages.SetString(tag, key, msg); err != nil {
+				panic("invalid translation of " + key + ": " + err.Error())
+			}
+		}
+	}
+}
+
+// Printer returns the printer renderers use for a locale such as "de" or "pt-BR". Empty
+// and unsupported locales are rendered in English
+func Printer(locale string) *message.Printer {
+	var tag = Locales[0]
+
+	if parsed, err := language.Parse(locale); err == nil {
+		if _, index, confidence := localeMatcher.Match(parsed); confidence != language.No {
+			tag = Locales[index]
+		}
+	}
+
+	return message.NewPrinter(tag, message.Catalog(messages))
+}
+
+// translate returns s in the language of p, strings that are not in the catalog (such as
+// actions GitHub adds later on) are returned as is
+func translate(p *message.Printer, s string) string {
+	// Strings from payloads must never be treated as a format string
+	if strings.Contains(s, "%") {
+		return s
+	}
+
+	return p.Sprintf(s)
+}
+
+// translateAction returns the action of an event in the language of p
+func translateAction(p *message.Printer, action string) string {
+	return translate(p, strings.ReplaceAll(action, "_", " "))
+}
+
+// TranslateAction returns an action in the language of p, for text built outside of the
+// renderers such as digest summaries
+func TranslateAction(p *message.Printer, action string) string {
+	return translateAction(p, action)
+}
+
+// label returns a bold "**name:**" label for a line of a value, with the name translated like
+// a field name
+func label(p *message.Printer, name string) string {
+	return "**" + translate(p, name) + ":**"
+}
+
+// Localize translates the field names of a rendered message. Renderers translate their
+// titles and other text themselves, field names are constant across renderers and are
+// translated here instead
+func Localize(messageSend *discordgo.MessageSend, p *message.Printer) {
+	for _, embed := range messageSend.Embeds {
+		for _, field := range embed.Fields {
+			field.Name = translate(p, field.Name)
+		}
+	}
+}
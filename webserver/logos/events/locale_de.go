@@ -0,0 +1,553 @@
+package events
+
+// German translations, any string missing here is rendered in English
+var messagesDE = map[string]string{
+	// Titles
+	" in %s":                   " in %s",
+	" on %s":                   " in %s",
+	" release on %s":           " Release in %s",
+	"%s %s created on %s":      "%s %s in %s erstellt",
+	"%s %s deleted on %s":      "%s %s in %s gelöscht",
+	"%s %s in a project of %s": "%s %s in einem Projekt von %s",
+	"%s Deployment status updated on: %s (%s)":         "%s Deployment-Status aktualisiert in: %s (%s)",
+	"%s Review %s on %s (#%s)":                         "%s Review %s in %s (#%s)",
+	"%s Review dismissed on %s (#%s)":                  "%s Review verworfen in %s (#%s)",
+	"%s Review edited on %s (#%s)":                     "%s Review bearbeitet in %s (#%s)",
+	"%s added as a collaborator on %s":                 "%s als Mitwirkende*r zu %s hinzugefügt",
+	"%s added to %s on %s":                             "%s zu %s bei %s hinzugefügt",
+	"%s added to team %s":                              "%s zum Team %s hinzugefügt",
+	"%s blocked from %s":                               "%s von %s blockiert",
+	"%s cancelled their sponsorship of %s":             "%s hat das Sponsoring von %s beendet",
+	"%s changed their sponsorship tier for %s":         "%s hat die Sponsoring-Stufe für %s geändert",
+	"%s installation %s on %s":                         "%s-Installation %s bei %s",
+	"%s installed on %s":                               "%s bei %s installiert",
+	"%s invited to %s":                                 "%s zu %s eingeladen",
+	"%s is now sponsoring %s":                          "%s sponsert jetzt %s",
+	"%s joined %s":                                     "%s ist %s beigetreten",
+	"%s removed as a collaborator from %s":             "%s als Mitwirkende*r von %s entfernt",
+	"%s removed from %s on %s":                         "%s von %s bei %s entfernt",
+	"%s removed from %s":                               "%s aus %s entfernt",
+	"%s removed from team %s":                          "%s aus dem Team %s entfernt",
+	"%s repositories":                                  "%s Repositories",
+	"1 repository":                                     "1 Repository",
+	"%s revoked their authorization of the GitHub App": "%s hat die Autorisierung der GitHub App widerrufen",
+	"%s suspended on %s":                               "%s bei %s gesperrt",
+	"%s unblocked from %s":                             "%s von %s entsperrt",
+	"%s uninstalled from %s":                           "%s bei %s deinstalliert",
+	"%s unsuspended on %s":                             "%s bei %s entsperrt",
+	"%s will cancel their sponsorship of %s":           "%s wird das Sponsoring von %s beenden",
+	"%s will change their sponsorship tier for %s":     "%s wird die Sponsoring-Stufe für %s ändern",
+	"A private sponsor":                                "Ein privater Sponsor",
+	"Branch protection rule deleted: %s":               "Branch-Schutzregel gelöscht: %s",
+	"Branch protection rule edited: %s":                "Branch-Schutzregel bearbeitet: %s",
+	"Check Run %s %s on %s":                            "Check Run %s %s in %s",
+	"Check Suite %s on %s":                             "Check Suite %s in %s",
+	"Code scanning alert #%s %s on %s":                 "Code-Scanning-Warnung #%s %s in %s",
+	"Collaborator %s %s on %s":                         "Mitwirkende*r %s %s in %s",
+	"Comment Deleted":                                  "Kommentar gelöscht",
+	"Comment on %s (#%s) %s":                           "Kommentar in %s (#%s) %s",
+	"Comment on commit %s (%s)":                        "Kommentar zu Commit %s (%s)",
+	"Created: %s":                                      "Erstellt: %s",
+	"Dependabot Alert on %s %s":                        "Dependabot-Warnung in %s %s",
+	"Deployment %s on %s":                              "Deployment %s in %s",
+	"Discussion Answered":                              "Diskussion beantwortet",
+	"Discussion Category Updated":                      "Diskussionskategorie aktualisiert",
+	"Discussion Closed":                                "Diskussion geschlossen",
+	"Discussion Comment Updated":                       "Diskussionskommentar aktualisiert",
+	"Discussion Deleted":                               "Diskussion gelöscht",
+	"Discussion Label Added":                           "Diskussionslabel hinzugefügt",
+	"Discussion Locked":                                "Diskussion gesperrt",
+	"Discussion Pinned":                                "Diskussion angeheftet",
+	"Discussion Reopened":                              "Diskussion wieder geöffnet",
+	"Discussion UnLocked":                              "Diskussion entsperrt",
+	"Discussion UnPinned":                              "Diskussion losgelöst",
+	"Discussion Updated":                               "Diskussion aktualisiert",
+	"Force push to %s on %s":                           "Force-Push auf %s in %s",
+	"GitHub App authorization %s by %s":                "GitHub-App-Autorisierung %s von %s",
+	"Issue %s on %s (#%s)":                             "Issue %s in %s (#%s)",
+	"Label %s %s on %s":                                "Label %s %s in %s",
+	"Merge group %s on %s":                             "Merge-Gruppe %s in %s",
+	"Merge queue checks requested on %s":               "Merge-Queue-Prüfungen in %s angefordert",
+	"Milestone %s %s on %s":                            "Meilenstein %s %s in %s",
+	"New %s created on %s":                             "Neuer %s in %s erstellt",
+	"New Comment on Discussion":                        "Neuer Kommentar zur Diskussion",
+	"New Discussion Created":                           "Neue Diskussion erstellt",
+	"New branch protection rule: %s":                   "Neue Branch-Schutzregel: %s",
+	"New fork: %s":                                     "Neuer Fork: %s",
+	"New permissions accepted for %s on %s":            "Neue Berechtigungen für %s bei %s akzeptiert",
+	"Organization %s %s":                               "Organisation %s %s",
+	"Organization %s deleted":                          "Organisation %s gelöscht",
+	"Organization renamed to %s":                       "Organisation umbenannt in %s",
+	"Page build: %s":                                   "Pages-Build: %s",
+	"Project %s %s on %s":                              "Projekt %s %s in %s",
+	"Pull Request %s on %s (#%s)":                      "Pull Request %s in %s (#%s)",
+	"Pull Request Review Comment on %s (#%s)":          "Review-Kommentar zum Pull Request in %s (#%s)",
+	"Push to %s on %s":                                 "Push auf %s in %s",
+	"Removed %s from %s":                               "%s aus %s entfernt",
+	"Repository update: %s":                            "Repository-Aktualisierung: %s",
+	"Ruleset %s %s on %s":                              "Regelsatz %s %s in %s",
+	"Secret scanning alert #%s %s on %s":               "Secret-Scanning-Warnung #%s %s in %s",
+	"Security advisory %s %s":                          "Sicherheitshinweis %s %s",
+	"Sponsorship of %s %s":                             "Sponsoring von %s %s",
+	"Starred: %s":                                      "Mit Stern markiert: %s",
+	"Status %s on %s":                                  "Status %s in %s",
+	"Tag %s moved on %s":                               "Tag %s in %s verschoben",
+	"Team %s":                                          "Team %s",
+	"Unstarred: %s":                                    "Stern entfernt: %s",
+	"Vulnerability alert %s on %s: %s":                 "Schwachstellenwarnung %s in %s: %s",
+	"Watch %s: %s":                                     "Beobachtung %s: %s",
+	"Wiki page %s %s on %s":                            "Wiki-Seite %s %s in %s",
+	"Wiki updated on %s":                               "Wiki in %s aktualisiert",
+	"Workflow Job: %s %s on %s":                        "Workflow-Job: %s %s in %s",
+	"Workflow Run: %s %s on %s":                        "Workflow-Lauf: %s %s in %s",
+	"📦 Package %s %s %s on %s":                         "📦 Paket %s %s %s in %s",
+	"appeared in branch %s":                            "im Branch %s aufgetreten",
+	"This event is not fully supported yet, [view the delivery log](%s)": "Dieses Ereignis wird noch nicht vollständig unterstützt, [Zustellungsprotokoll ansehen](%s)",
+
+	// Titles of events without a renderer of their own, by event name
+	"Branch Protection Configuration": "Branch-Schutzkonfiguration",
+	"Custom Property":                 "Benutzerdefinierte Eigenschaft",
+	"Custom Property Values":          "Werte benutzerdefinierter Eigenschaften",
+	"Deploy Key":                      "Deploy-Schlüssel",
+	"Deployment Protection Rule":      "Deployment-Schutzregel",
+	"Deployment Review":               "Deployment-Review",
+	"Installation Target":             "Installationsziel",
+	"Marketplace Purchase":            "Marketplace-Kauf",
+	"Meta":                            "Meta",
+	"Personal Access Token Request":   "Anfrage für persönliches Zugriffstoken",
+	"Ping":                            "Ping",
+	"Project Card":                    "Projektkarte",
+	"Project Column":                  "Projektspalte",
+	"Projects V2":                     "Projekte V2",
+	"Projects V2 Status Update":       "Statusupdate von Projekte V2",
+	"Repository Advisory":             "Repository-Sicherheitshinweis",
+	"Repository Dispatch":             "Repository-Dispatch",
+	"Repository Import":               "Repository-Import",
+	"Security And Analysis":           "Sicherheit und Analyse",
+	"Sub Issues":                      "Unter-Issues",
+	"Team Add":                        "Team hinzugefügt",
+	"Workflow Dispatch":               "Workflow-Dispatch",
+
+	// Digests and events collapsed due to rate limits
+	"Digest of %d events":                    "Zusammenfassung von %d Ereignissen",
+	"%d events collapsed due to rate limits": "%d Ereignisse wegen Ratenbegrenzung zusammengefasst",
+	"1 push":                                 "1 Push",
+	"%d pushes":                              "%d Pushes",
+	"1 PR":                                   "1 PR",
+	"%d PRs":                                 "%d PRs",
+	"1 review comment":                       "1 Review-Kommentar",
+	"%d review comments":                     "%d Review-Kommentare",
+	"1 issue":                                "1 Issue",
+	"%d issues":                              "%d Issues",
+	"1 comment":                              "1 Kommentar",
+	"%d comments":                            "%d Kommentare",
+	"1 commit comment":                       "1 Commit-Kommentar",
+	"%d commit comments":                     "%d Commit-Kommentare",
+	"1 workflow run":                         "1 Workflow-Lauf",
+	"%d workflow runs":                       "%d Workflow-Läufe",
+	"1 workflow job":                         "1 Workflow-Job",
+	"%d workflow jobs":                       "%d Workflow-Jobs",
+	"1 check run":                            "1 Check-Lauf",
+	"%d check runs":                          "%d Check-Läufe",
+	"1 check suite":                          "1 Check-Suite",
+	"%d check suites":                        "%d Check-Suites",
+	"1 star":                                 "1 Stern",
+	"%d stars":                               "%d Sterne",
+	"1 watcher":                              "1 Beobachter*in",
+	"%d watchers":                            "%d Beobachter*innen",
+	"1 fork":                                 "1 Fork",
+	"%d forks":                               "%d Forks",
+	"1 release":                              "1 Release",
+	"%d releases":                            "%d Releases",
+	"1 ref created":                          "1 Ref erstellt",
+	"%d refs created":                        "%d Refs erstellt",
+	"1 ref deleted":                          "1 Ref gelöscht",
+	"%d refs deleted":                        "%d Refs gelöscht",
+	"1 %s event":                             "1 %s-Ereignis",
+	"%d %s events":                           "%d %s-Ereignisse",
+
+	// One-line summaries
+	"%s pushed %s to %s: %s":                        "%[1]s hat %[2]s nach %[3]s gepusht: %[4]s",
+	"%s force-pushed %s to %s: %s":                  "%[1]s hat %[2]s nach %[3]s force-gepusht: %[4]s",
+	"1 commit":                                      "1 Commit",
+	"%s commits":                                    "%s Commits",
+	"%s created %s":                                 "%s hat %s erstellt",
+	"%s deleted %s":                                 "%s hat %s gelöscht",
+	"%s updated %s":                                 "%s hat %s aktualisiert",
+	"%s %s pull request #%s: %s":                    "%[1]s hat Pull Request #%[3]s %[2]s: %[4]s",
+	"%s %s issue #%s: %s":                           "%[1]s hat Issue #%[3]s %[2]s: %[4]s",
+	"%s %s a review on #%s: %s":                     "%[1]s hat ein Review zu #%[3]s %[2]s: %[4]s",
+	"%s %s a comment on %s":                         "%[1]s hat einen Kommentar zu %[3]s %[2]s",
+	"%s commented on %s: %s":                        "%s hat %s kommentiert: %s",
+	"%s approved #%s: %s":                           "%s hat #%s genehmigt: %s",
+	"%s requested changes on #%s: %s":               "%s hat Änderungen an #%s angefordert: %s",
+	"%s reviewed #%s: %s":                           "%s hat #%s überprüft: %s",
+	"%s %s release %s":                              "%[1]s hat Release %[3]s %[2]s",
+	"%s starred the repository":                     "%s hat das Repository mit einem Stern markiert",
+	"%s unstarred the repository":                   "%s hat den Stern vom Repository entfernt",
+	"%s forked the repository to %s":                "%s hat das Repository nach %s geforkt",
+	"Workflow %s #%s %s on %s":                      "Workflow %s #%s %s auf %s",
+	"%s %s branch protection rule %s":               "%[1]s hat die Branch-Schutzregel %[3]s %[2]s",
+	"Check suite %s on %s (%s)":                     "Check-Suite %s auf %s (%s)",
+	"Status %s on %s: %s":                           "Status %s für %s: %s",
+	"%s %s a deployment of %s to %s":                "%[1]s hat ein Deployment von %[3]s nach %[4]s %[2]s",
+	"Deployment of %s: %s":                          "Deployment von %s: %s",
+	"%s %s discussion #%s: %s":                      "%[1]s hat Diskussion #%[3]s %[2]s: %[4]s",
+	"Dependabot alert %s for %s (%s)":               "Dependabot-Warnung %s für %s (%s)",
+	"Job %s of %s %s on %s":                         "Job %s von %s %s auf %s",
+	"Check run %s %s on %s":                         "Check-Lauf %s %s auf %s",
+	"%s made the repository public":                 "%s hat das Repository öffentlich gemacht",
+	"%s started watching the repository":            "%s beobachtet jetzt das Repository",
+	"%s %s the repository":                          "%[1]s hat das Repository %[2]s",
+	"%s %s team %s":                                 "%[1]s hat Team %[3]s %[2]s",
+	"GitHub Pages build %s for %s":                  "GitHub-Pages-Build %s für %s",
+	"Vulnerability alert %s for %s":                 "Schwachstellenwarnung %s für %s",
+	"Code scanning alert #%s %s: %s":                "Code-Scanning-Warnung #%s %s: %s",
+	"Secret scanning alert #%s %s: %s":              "Secret-Scanning-Warnung #%s %s: %s",
+	"%s %s label %s":                                "%[1]s hat Label %[3]s %[2]s",
+	"%s %s milestone %s":                            "%[1]s hat Meilenstein %[3]s %[2]s",
+	"%s %s collaborator %s":                         "%[1]s hat Mitarbeiter %[3]s %[2]s",
+	"%s %s %s (team %s)":                            "%[1]s hat %[3]s %[2]s (Team %[4]s)",
+	"Merge group %s for %s: %s":                     "Merge-Gruppe %s für %s: %s",
+	"%s %s ruleset %s":                              "%[1]s hat Regelsatz %[3]s %[2]s",
+	"%s %s wiki page %s":                            "%[1]s hat Wiki-Seite %[3]s %[2]s",
+	"(and %s more)":                                 "(und %s weitere)",
+	"Sponsorship of %s by %s %s: %s":                "Sponsoring von %s durch %s %s: %s",
+	"Sponsorship of %s by a private sponsor %s: %s": "Sponsoring von %s durch einen privaten Sponsor %s: %s",
+	"Security advisory %s %s: %s":                   "Sicherheitshinweis %s %s: %s",
+	"%s %s a GitHub App on %s":                      "%[1]s hat eine GitHub-App in %[3]s %[2]s",
+	"%s %s repositories of a GitHub App: %s":        "%[1]s hat Repositories einer GitHub-App %[2]s: %[3]s",
+	"%s %s the GitHub App %s on %s":                 "%[1]s hat die GitHub-App %[3]s in %[4]s %[2]s",
+	"%s %s repositories of the GitHub App %s: %s":   "%[1]s hat Repositories der GitHub-App %[3]s %[2]s: %[4]s",
+	"%s %s their authorization of the GitHub App":   "%[1]s hat die Autorisierung der GitHub-App %[2]s",
+	"%s %s the organization":                        "%[1]s hat die Organisation %[2]s",
+	"Organization %s by %s: %s":                     "Organisation %s von %s: %s",
+	"%s %s user %s":                                 "%[1]s hat Benutzer %[3]s %[2]s",
+	"%s %s project %s":                              "%[1]s hat Projekt %[3]s %[2]s",
+	"%s %s %s in a project":                         "%[1]s hat %[3]s in einem Projekt %[2]s",
+	"%s %s package %s":                              "%[1]s hat Paket %[3]s %[2]s",
+
+	// Placeholders
+	"No URL available":             "Keine URL verfügbar",
+	"No affected packages listed":  "Keine betroffenen Pakete angegeben",
+	"No conclusion yet!":           "Noch kein Ergebnis!",
+	"No description available":     "Keine Beschreibung verfügbar",
+	"No description provided.":     "Keine Beschreibung angegeben.",
+	"No due date":                  "Kein Fälligkeitsdatum",
+	"No errors yet!":               "Bisher keine Fehler!",
+	"No permissions provided.":     "Keine Berechtigungen angegeben.",
+	"No privacy settings set.":     "Keine Sichtbarkeit festgelegt.",
+	"Read more":                    "Weiterlesen",
+	"No reason provided":           "Kein Grund angegeben",
+	"No review body":               "Kein Review-Text",
+	"No status yet!":               "Noch kein Status!",
+	"*none*":                       "*keine*",
+	"No commits?":                  "Keine Commits?",
+	"No patched version available": "Keine gepatchte Version verfügbar",
+	"Not dismissed":                "Nicht verworfen",
+	"Not scored":                   "Nicht bewertet",
+
+	// Descriptions and other sentences used in values
+	"(excluding %s)": "(außer %s)",
+	"Adding new comments/answers is now prohibited": "Neue Kommentare/Antworten sind jetzt nicht mehr möglich",
+	"Discussion is now Closed: %s":                  "Die Diskussion ist jetzt geschlossen: %s",
+	"Discussion is still open for comments":         "Die Diskussion ist weiterhin für Kommentare offen",
+	"Failed at: %s":                                 "Fehlgeschlagen bei: %s",
+	"It looks like this comment has received a update that is not tracked by our systems yet!":    "Dieser Kommentar hat anscheinend eine Änderung erhalten, die unsere Systeme noch nicht erfassen!",
+	"It looks like this discussion has received a update that is not tracked by our systems yet!": "Diese Diskussion hat anscheinend eine Änderung erhalten, die unsere Systeme noch nicht erfassen!",
+	"No": "Nein",
+	"This discussion has been closed and will no longer allow new comments/posts": "Diese Diskussion wurde geschlossen und erlaubt keine neuen Kommentare/Beiträge mehr",
+	"This discussion has been moved to a new category!":                           "Diese Diskussion wurde in eine neue Kategorie verschoben!",
+	"This discussion has been reopened":                                           "Diese Diskussion wurde wieder geöffnet",
+	"This secret was pushed even though push protection blocked it":               "Dieses Secret wurde gepusht, obwohl der Push-Schutz es blockiert hat",
+	"Yes":                                  "Ja",
+	"You are free to answer/comment again": "Antworten/Kommentare sind wieder möglich",
+	"changed from %s":                      "geändert von %s",
+
+	"...and %d more":                  "...und %d weitere",
+	", %d distinct":                   ", %d eindeutig",
+	" (attempt %d)":                   " (Versuch %d)",
+	" (private)":                      " (privat)",
+	"no patch yet":                    "noch kein Patch",
+	"**%s** (%s) %s, patched in %s\n": "**%s** (%s) %s, gepatcht in %s\n",
+	"Compare changes":                 "Änderungen vergleichen",
+	"View all commits":                "Alle Commits ansehen",
+	// Actions, states and other words used in titles
+	"action required":         "Aktion erforderlich",
+	"added":                   "hinzugefügt",
+	"added to repository":     "zum Repository hinzugefügt",
+	"answered":                "beantwortet",
+	"approved":                "genehmigt",
+	"Approved":                "Genehmigt",
+	"archived":                "archiviert",
+	"assigned":                "zugewiesen",
+	"blocked":                 "blockiert",
+	"branch":                  "Branch",
+	"Branch":                  "Branch",
+	"cancelled":               "abgebrochen",
+	"category changed":        "Kategorie geändert",
+	"Changes Requested":       "Änderungen angefordert",
+	"checks requested":        "Prüfungen angefordert",
+	"closed":                  "geschlossen",
+	"Commented":               "Kommentiert",
+	"completed":               "abgeschlossen",
+	"converted to draft":      "in Entwurf umgewandelt",
+	"created":                 "erstellt",
+	"deleted":                 "gelöscht",
+	"destroyed":               "aufgelöst",
+	"dismissed":               "verworfen",
+	"Dismissed":               "Verworfen",
+	"Draft issue":             "Entwurfs-Issue",
+	"edited":                  "bearbeitet",
+	"error":                   "Fehler",
+	"failed":                  "fehlgeschlagen",
+	"failure":                 "fehlgeschlagen",
+	"fixed":                   "behoben",
+	"in progress":             "läuft",
+	"Item":                    "Eintrag",
+	"labeled":                 "mit Label versehen",
+	"locked":                  "gesperrt",
+	"member added":            "Mitglied hinzugefügt",
+	"member invited":          "Mitglied eingeladen",
+	"member removed":          "Mitglied entfernt",
+	"merged":                  "zusammengeführt",
+	"neutral":                 "neutral",
+	"open":                    "offen",
+	"opened":                  "geöffnet",
+	"pending":                 "ausstehend",
+	"pinned":                  "angeheftet",
+	"prereleased":             "als Vorabversion veröffentlicht",
+	"published":               "veröffentlicht",
+	"Pull request":            "Pull Request",
+	"queued":                  "in Warteschlange",
+	"ready for review":        "bereit für Review",
+	"released":                "freigegeben",
+	"removed":                 "entfernt",
+	"removed from repository": "aus dem Repository entfernt",
+	"renamed":                 "umbenannt",
+	"reopened":                "wieder geöffnet",
+	"repository":              "Repository",
+	"requested":               "angefordert",
+	"rerequested":             "erneut angefordert",
+	"resolved":                "gelöst",
+	"restored":                "wiederhergestellt",
+	"review requested":        "Review angefordert",
+	"skipped":                 "übersprungen",
+	"stale":                   "veraltet",
+	"started":                 "gestartet",
+	"succeeded":               "erfolgreich",
+	"success":                 "erfolgreich",
+	"synchronize":             "synchronisiert",
+	"tag":                     "Tag",
+	"timed out":               "Zeitüberschreitung",
+	"transferred":             "übertragen",
+	"unarchived":              "dearchiviert",
+	"unassigned":              "Zuweisung entfernt",
+	"unblocked":               "entsperrt",
+	"unlabeled":               "Label entfernt",
+	"unlocked":                "entsperrt",
+	"unpinned":                "losgelöst",
+	"unpublished":             "zurückgezogen",
+	"updated":                 "aktualisiert",
+	"waiting":                 "wartet",
+
+	// Field names
+	"CVSS":                                "CVSS",
+	"Commit":                              "Commit",
+	"Commits (%s)":                        "Commits (%s)",
+	"Head SHA":                            "Head-SHA",
+	"Is Production (according to github)": "Produktivumgebung (laut GitHub)",
+	"Is Transient Environment":            "Temporäre Umgebung",
+	"Pull Request":                        "Pull Request",
+	"Ref":                                 "Ref",
+	"Refs":                                "Refs",
+	"Runner":                              "Runner",
+	"Sponsor":                             "Sponsor*in",
+	"Status":                              "Status",
+	"Tag":                                 "Tag",
+	"Team":                                "Team",
+	"URL":                                 "URL",
+	"Account":                             "Konto",
+	"Action":                              "Aktion",
+	"Action Taken":                        "Durchgeführte Aktion",
+	"Added":                               "Hinzugefügt",
+	"Added By":                            "Hinzugefügt von",
+	"Affected Packages":                   "Betroffene Pakete",
+	"Answer Posted By":                    "Antwort verfasst von",
+	"Answer Selected By":                  "Antwort ausgewählt von",
+	"Attempt":                             "Versuch",
+	"Author":                              "Autor*in",
+	"Base Ref":                            "Basis-Ref",
+	"Blocked User":                        "Blockierte*r Nutzer*in",
+	"Body":                                "Inhalt",
+	"Category":                            "Kategorie",
+	"Changes":                             "Änderungen",
+	"Closed By":                           "Geschlossen von",
+	"Collaborator":                        "Mitwirkende*r",
+	"Color":                               "Farbe",
+	"Comment":                             "Kommentar",
+	"Comment Author":                      "Kommentar von",
+	"Commit Sender":                       "Commit von",
+	"Conclusion":                          "Ergebnis",
+	"Context":                             "Kontext",
+	"Created By":                          "Erstellt von",
+	"Creator":                             "Ersteller*in",
+	"Default Label?":                      "Standardlabel?",
+	"Deleted By":                          "Gelöscht von",
+	"Description":                         "Beschreibung",
+	"Details":                             "Details",
+	"Details URL":                         "Details-URL",
+	"Discussion":                          "Diskussion",
+	"Discussion Locked?":                  "Diskussion gesperrt?",
+	"Dismissal Details":                   "Details zum Verwerfen",
+	"Due On":                              "Fällig am",
+	"Duration":                            "Dauer",
+	"Ecosystem":                           "Ökosystem",
+	"Effective":                           "Wirksam ab",
+	"Environment":                         "Umgebung",
+	"Environment URL":                     "Umgebungs-URL",
+	"Errors":                              "Fehler",
+	"Event":                               "Ereignis",
+	"Events":                              "Ereignisse",
+	"Force Push":                          "Force-Push",
+	"Forked repo":                         "Geforktes Repository",
+	"Head Commit":                         "Head-Commit",
+	"Identifiers":                         "Kennungen",
+	"Install":                             "Installation",
+	"Issues":                              "Issues",
+	"Label Description":                   "Labelbeschreibung",
+	"Label Name":                          "Labelname",
+	"Last Commit":                         "Letzter Commit",
+	"Location":                            "Fundort",
+	"Locked By":                           "Gesperrt von",
+	"Log URL":                             "Log-URL",
+	"Master Branch":                       "Hauptbranch",
+	"Member":                              "Mitglied",
+	"Membership":                          "Mitgliedschaft",
+	"More Information":                    "Weitere Informationen",
+	"Name":                                "Name",
+	"New Category":                        "Neue Kategorie",
+	"Opened By":                           "Geöffnet von",
+	"Origin Discussion":                   "Ursprüngliche Diskussion",
+	"Original repo":                       "Ursprüngliches Repository",
+	"Package":                             "Paket",
+	"Parent Issue":                        "Übergeordnetes Issue",
+	"Payload":                             "Nutzlast",
+	"Permission":                          "Berechtigung",
+	"Permissions":                         "Berechtigungen",
+	"Pinned By":                           "Angeheftet von",
+	"Privacy":                             "Sichtbarkeit",
+	"Project":                             "Projekt",
+	"Publisher":                           "Veröffentlicht von",
+	"Pull Requests":                       "Pull Requests",
+	"Pusher":                              "Gepusht von",
+	"Pusher Type":                         "Art des Pushs",
+	"Reason":                              "Grund",
+	"Ref Type":                            "Ref-Typ",
+	"Release":                             "Release",
+	"Removed":                             "Entfernt",
+	"Repositories":                        "Repositories",
+	"Repository":                          "Repository",
+	"Repository Selection":                "Repository-Auswahl",
+	"Resolution Details":                  "Details zur Lösung",
+	"Reviewer":                            "Reviewer*in",
+	"Role":                                "Rolle",
+	"Rule":                                "Regel",
+	"Run Number":                          "Laufnummer",
+	"Secret Type":                         "Secret-Typ",
+	"Severity":                            "Schweregrad",
+	"State":                               "Zustand",
+	"Steps":                               "Schritte",
+	"Summary":                             "Zusammenfassung",
+	"Target URL":                          "Ziel-URL",
+	"Task":                                "Aufgabe",
+	"Team Permission":                     "Team-Berechtigung",
+	"Tier":                                "Stufe",
+	"Title":                               "Titel",
+	"Tool":                                "Werkzeug",
+	"Triggered By":                        "Ausgelöst von",
+	"Unlocked By":                         "Entsperrt von",
+	"Unpinned By":                         "Losgelöst von",
+	"User":                                "Nutzer*in",
+	"Validity":                            "Gültigkeit",
+	"Version":                             "Version",
+	"View Answer":                         "Antwort ansehen",
+	"View Discussion":                     "Diskussion ansehen",
+	"Visibility":                          "Sichtbarkeit",
+	"Vulnerabilities":                     "Schwachstellen",
+	"Weaknesses":                          "Schwächen",
+	"Workflow Name":                       "Workflow-Name",
+	"⚠️ Push Protection Bypassed":         "⚠️ Push-Schutz umgangen",
+
+	// Labels inside values and names of settings
+	"Admin enforced":                     "Für Admins erzwungen",
+	"Advisory":                           "Sicherheitshinweis",
+	"After":                              "Nachher",
+	"Allow deletions":                    "Löschen erlauben",
+	"Allow force pushes":                 "Force-Pushes erlauben",
+	"Applies to":                         "Gilt für",
+	"Authorized actors":                  "Berechtigte Akteure",
+	"Authorized actors only":             "Nur berechtigte Akteure",
+	"Authorized dismissal actors only":   "Nur berechtigte Akteure dürfen Reviews verwerfen",
+	"Base":                               "Basis",
+	"Base Label":                         "Basis-Label",
+	"Before":                             "Vorher",
+	"Block force pushes":                 "Force-Pushes blockieren",
+	"Branch name pattern":                "Muster für Branch-Namen",
+	"Bypass actors":                      "Akteure mit Ausnahme",
+	"Bypassed By":                        "Umgangen von",
+	"CVE":                                "CVE",
+	"Commit author email pattern":        "Muster für Commit-Autor-E-Mails",
+	"Commit message pattern":             "Muster für Commit-Nachrichten",
+	"Committer email pattern":            "Muster für Committer-E-Mails",
+	"Conditions changed":                 "Geänderte Bedingungen",
+	"Could be fixed by resolving":        "Behebbar durch Aktualisieren von",
+	"Create protected":                   "Erstellen geschützt",
+	"Default Branch":                     "Standard-Branch",
+	"Dismiss stale reviews on push":      "Veraltete Reviews bei Push verwerfen",
+	"Dismissed By":                       "Verworfen von",
+	"Dismissed Reason":                   "Grund für das Verwerfen",
+	"Enforcement":                        "Durchsetzung",
+	"Field changed":                      "Geändertes Feld",
+	"First Patched Version":              "Erste gepatchte Version",
+	"Fixed In":                           "Behoben in",
+	"GHSA ID":                            "GHSA-ID",
+	"Head":                               "Head",
+	"Head Label":                         "Head-Label",
+	"Head Ref":                           "Head-Ref",
+	"Homepage":                           "Homepage",
+	"Ignore approvals from contributors": "Genehmigungen von Mitwirkenden ignorieren",
+	"Linear history requirement":         "Lineare Historie erforderlich",
+	"Login":                              "Login",
+	"Manifest Path":                      "Manifest-Pfad",
+	"Merge queue requirement":            "Merge-Queue erforderlich",
+	"Pull request reviews requirement":   "Pull-Request-Reviews erforderlich",
+	"Require a pull request":             "Pull Request erforderlich",
+	"Require code scanning results":      "Code-Scanning-Ergebnisse erforderlich",
+	"Require deployments":                "Deployments erforderlich",
+	"Require linear history":             "Lineare Historie erforderlich",
+	"Require merge queue":                "Merge-Queue erforderlich",
+	"Require signed commits":             "Signierte Commits erforderlich",
+	"Require status checks":              "Statuschecks erforderlich",
+	"Required approving review count":    "Anzahl erforderlicher Genehmigungen",
+	"Required conversation resolution":   "Auflösung von Unterhaltungen erforderlich",
+	"Required deployments":               "Erforderliche Deployments",
+	"Required status checks":             "Erforderliche Statuschecks",
+	"Resolution":                         "Auflösung",
+	"Resolved By":                        "Aufgelöst von",
+	"Restrict creations":                 "Erstellen einschränken",
+	"Restrict deletions":                 "Löschen einschränken",
+	"Restrict updates":                   "Aktualisieren einschränken",
+	"Rules added":                        "Hinzugefügte Regeln",
+	"Rules removed":                      "Entfernte Regeln",
+	"Rules updated":                      "Aktualisierte Regeln",
+	"Scope":                              "Bereich",
+	"Settings":                           "Einstellungen",
+	"Signature requirement":              "Signatur erforderlich",
+	"Strict status checks":               "Strikte Statuschecks",
+	"Tag name pattern":                   "Muster für Tag-Namen",
+	"Topics":                             "Themen",
+	"Target":                             "Ziel",
+	"Type":                               "Typ",
+	"Vulnerable Version Range":           "Betroffener Versionsbereich",
+}
@@ -0,0 +1,551 @@
+package events
+
+// Portuguese translations, any string missing here is rendered in English
+var messagesPT = map[string]string{
+	// Titles
+	" in %s":                   " em %s",
+	" on %s":                   " em %s",
+	" release on %s":           " release em %s",
+	"%s %s created on %s":      "%s %s criado em %s",
+	"%s %s deleted on %s":      "%s %s excluído em %s",
+	"%s %s in a project of %s": "%s %s em um projeto de %s",
+	"%s Deployment status updated on: %s (%s)":         "%s Status do deployment atualizado em: %s (%s)",
+	"%s Review %s on %s (#%s)":                         "%s Revisão %s em %s (#%s)",
+	"%s Review dismissed on %s (#%s)":                  "%s Revisão descartada em %s (#%s)",
+	"%s Review edited on %s (#%s)":                     "%s Revisão editada em %s (#%s)",
+	"%s added as a collaborator on %s":                 "%s adicionado(a) como colaborador(a) em %s",
+	"%s added to %s on %s":                             "%s adicionado(a) a %s em %s",
+	"%s added to team %s":                              "%s adicionado(a) à equipe %s",
+	"%s blocked from %s":                               "%s bloqueado(a) em %s",
+	"%s cancelled their sponsorship of %s":             "%s cancelou o patrocínio de %s",
+	"%s changed their sponsorship tier for %s":         "%s alterou o nível de patrocínio de %s",
+	"%s installation %s on %s":                         "Instalação de %s %s em %s",
+	"%s installed on %s":                               "%s instalado em %s",
+	"%s invited to %s":                                 "%s convidado(a) para %s",
+	"%s is now sponsoring %s":                          "%s agora patrocina %s",
+	"%s joined %s":                                     "%s entrou em %s",
+	"%s removed as a collaborator from %s":             "%s removido(a) como colaborador(a) de %s",
+	"%s removed from %s on %s":                         "%s removido(a) de %s em %s",
+	"%s removed from %s":                               "%s removido(a) de %s",
+	"%s removed from team %s":                          "%s removido(a) da equipe %s",
+	"%s repositories":                                  "%s repositórios",
+	"1 repository":                                     "1 repositório",
+	"%s revoked their authorization of the GitHub App": "%s revogou a autorização do GitHub App",
+	"%s suspended on %s":                               "%s suspenso em %s",
+	"%s unblocked from %s":                             "%s desbloqueado(a) em %s",
+	"%s uninstalled from %s":                           "%s desinstalado de %s",
+	"%s unsuspended on %s":                             "%s reativado em %s",
+	"%s will cancel their sponsorship of %s":           "%s vai cancelar o patrocínio de %s",
+	"%s will change their sponsorship tier for %s":     "%s vai alterar o nível de patrocínio de %s",
+	"A private sponsor":                                "Um patrocinador privado",
+	"Branch protection rule deleted: %s":               "Regra de proteção de branch excluída: %s",
+	"Branch protection rule edited: %s":                "Regra de proteção de branch editada: %s",
+	"Check Run %s %s on %s":                            "Check Run %s %s em %s",
+	"Check Suite %s on %s":                             "Check Suite %s em %s",
+	"Code scanning alert #%s %s on %s":                 "Alerta de code scanning #%s %s em %s",
+	"Collaborator %s %s on %s":                         "Colaborador(a) %s %s em %s",
+	"Comment Deleted":                                  "Comentário excluído",
+	"Comment on %s (#%s) %s":                           "Comentário em %s (#%s) %s",
+	"Comment on commit %s (%s)":                        "Comentário no commit %s (%s)",
+	"Commits (%s)":                                     "Commits (%s)",
+	"Created: %s":                                      "Criado: %s",
+	"Dependabot Alert on %s %s":                        "Alerta do Dependabot em %s %s",
+	"Deployment %s on %s":                              "Deployment %s em %s",
+	"Discussion Answered":                              "Discussão respondida",
+	"Discussion Category Updated":                      "Categoria da discussão atualizada",
+	"Discussion Closed":                                "Discussão fechada",
+	"Discussion Comment Updated":                       "Comentário da discussão atualizado",
+	"Discussion Deleted":                               "Discussão excluída",
+	"Discussion Label Added":                           "Label adicionado à discussão",
+	"Discussion Locked":                                "Discussão bloqueada",
+	"Discussion Pinned":                                "Discussão fixada",
+	"Discussion Reopened":                              "Discussão reaberta",
+	"Discussion UnLocked":                              "Discussão desbloqueada",
+	"Discussion UnPinned":                              "Discussão desafixada",
+	"Discussion Updated":                               "Discussão atualizada",
+	"Force push to %s on %s":                           "Force push para %s em %s",
+	"GitHub App authorization %s by %s":                "Autorização do GitHub App %s por %s",
+	"Issue %s on %s (#%s)":                             "Issue %s em %s (#%s)",
+	"Label %s %s on %s":                                "Label %s %s em %s",
+	"Merge group %s on %s":                             "Grupo de merge %s em %s",
+	"Merge queue checks requested on %s":               "Verificações da fila de merge solicitadas em %s",
+	"Milestone %s %s on %s":                            "Milestone %s %s em %s",
+	"New %s created on %s":                             "Novo %s criado em %s",
+	"New Comment on Discussion":                        "Novo comentário na discussão",
+	"New Discussion Created":                           "Nova discussão criada",
+	"New branch protection rule: %s":                   "Nova regra de proteção de branch: %s",
+	"New fork: %s":                                     "Novo fork: %s",
+	"New permissions accepted for %s on %s":            "Novas permissões aceitas para %s em %s",
+	"Organization %s %s":                               "Organização %s %s",
+	"Organization %s deleted":                          "Organização %s excluída",
+	"Organization renamed to %s":                       "Organização renomeada para %s",
+	"Page build: %s":                                   "Build do Pages: %s",
+	"Project %s %s on %s":                              "Projeto %s %s em %s",
+	"Pull Request %s on %s (#%s)":                      "Pull Request %s em %s (#%s)",
+	"Pull Request Review Comment on %s (#%s)":          "Comentário de revisão no Pull Request em %s (#%s)",
+	"Push to %s on %s":                                 "Push para %s em %s",
+	"Removed %s from %s":                               "%s removido(a) de %s",
+	"Repository update: %s":                            "Atualização do repositório: %s",
+	"Ruleset %s %s on %s":                              "Conjunto de regras %s %s em %s",
+	"Secret scanning alert #%s %s on %s":               "Alerta de secret scanning #%s %s em %s",
+	"Security advisory %s %s":                          "Aviso de segurança %s %s",
+	"Sponsorship of %s %s":                             "Patrocínio de %s %s",
+	"Starred: %s":                                      "Estrela adicionada: %s",
+	"Status %s on %s":                                  "Status %s em %s",
+	"Tag %s moved on %s":                               "Tag %s movida em %s",
+	"Team %s":                                          "Equipe %s",
+	"Unstarred: %s":                                    "Estrela removida: %s",
+	"Vulnerability alert %s on %s: %s":                 "Alerta de vulnerabilidade %s em %s: %s",
+	"Watch %s: %s":                                     "Acompanhamento %s: %s",
+	"Wiki page %s %s on %s":                            "Página da wiki %s %s em %s",
+	"Wiki updated on %s":                               "Wiki atualizada em %s",
+	"Workflow Job: %s %s on %s":                        "Job do workflow: %s %s em %s",
+	"Workflow Run: %s %s on %s":                        "Execução do workflow: %s %s em %s",
+	"📦 Package %s %s %s on %s":                         "📦 Pacote %s %s %s em %s",
+	"appeared in branch %s":                            "apareceu no branch %s",
+	"This event is not fully supported yet, [view the delivery log](%s)": "Este evento ainda não é totalmente suportado, [ver o registro de entrega](%s)",
+
+	// Titles of events without a renderer of their own, by event name
+	"Branch Protection Configuration": "Configuração de proteção de branch",
+	"Custom Property":                 "Propriedade personalizada",
+	"Custom Property Values":          "Valores de propriedades personalizadas",
+	"Deploy Key":                      "Chave de deploy",
+	"Deployment Protection Rule":      "Regra de proteção de deployment",
+	"Deployment Review":               "Revisão de deployment",
+	"Installation Target":             "Destino da instalação",
+	"Marketplace Purchase":            "Compra no Marketplace",
+	"Meta":                            "Meta",
+	"Personal Access Token Request":   "Solicitação de token de acesso pessoal",
+	"Ping":                            "Ping",
+	"Project Card":                    "Cartão de projeto",
+	"Project Column":                  "Coluna de projeto",
+	"Projects V2":                     "Projetos V2",
+	"Projects V2 Status Update":       "Atualização de status de Projetos V2",
+	"Repository Advisory":             "Aviso de segurança do repositório",
+	"Repository Dispatch":             "Dispatch de repositório",
+	"Repository Import":               "Importação de repositório",
+	"Security And Analysis":           "Segurança e análise",
+	"Sub Issues":                      "Sub-issues",
+	"Team Add":                        "Equipe adicionada",
+	"Workflow Dispatch":               "Dispatch de workflow",
+
+	// Digests and events collapsed due to rate limits
+	"Digest of %d events":                    "Resumo de %d eventos",
+	"%d events collapsed due to rate limits": "%d eventos agrupados devido aos limites de taxa",
+	"1 push":                                 "1 push",
+	"%d pushes":                              "%d pushes",
+	"1 PR":                                   "1 PR",
+	"%d PRs":                                 "%d PRs",
+	"1 review comment":                       "1 comentário de revisão",
+	"%d review comments":                     "%d comentários de revisão",
+	"1 issue":                                "1 issue",
+	"%d issues":                              "%d issues",
+	"1 comment":                              "1 comentário",
+	"%d comments":                            "%d comentários",
+	"1 commit comment":                       "1 comentário de commit",
+	"%d commit comments":                     "%d comentários de commit",
+	"1 workflow run":                         "1 execução de workflow",
+	"%d workflow runs":                       "%d execuções de workflow",
+	"1 workflow job":                         "1 job de workflow",
+	"%d workflow jobs":                       "%d jobs de workflow",
+	"1 check run":                            "1 execução de check",
+	"%d check runs":                          "%d execuções de check",
+	"1 check suite":                          "1 suíte de checks",
+	"%d check suites":                        "%d suítes de checks",
+	"1 star":                                 "1 estrela",
+	"%d stars":                               "%d estrelas",
+	"1 watcher":                              "1 observador",
+	"%d watchers":                            "%d observadores",
+	"1 fork":                                 "1 fork",
+	"%d forks":                               "%d forks",
+	"1 release":                              "1 release",
+	"%d releases":                            "%d releases",
+	"1 ref created":                          "1 ref criada",
+	"%d refs created":                        "%d refs criadas",
+	"1 ref deleted":                          "1 ref excluída",
+	"%d refs deleted":                        "%d refs excluídas",
+	"1 %s event":                             "1 evento %s",
+	"%d %s events":                           "%d eventos %s",
+
+	// One-line summaries
+	"%s pushed %s to %s: %s":                        "%s enviou %s para %s: %s",
+	"%s force-pushed %s to %s: %s":                  "%s enviou à força %s para %s: %s",
+	"%s created %s":                                 "%s criou %s",
+	"%s deleted %s":                                 "%s excluiu %s",
+	"%s updated %s":                                 "%s atualizou %s",
+	"%s %s pull request #%s: %s":                    "Pull request #%[3]s %[2]s por %[1]s: %[4]s",
+	"%s %s issue #%s: %s":                           "Issue #%[3]s %[2]s por %[1]s: %[4]s",
+	"%s %s a review on #%s: %s":                     "Revisão em #%[3]s %[2]s por %[1]s: %[4]s",
+	"%s %s a comment on %s":                         "Comentário em %[3]s %[2]s por %[1]s",
+	"%s commented on %s: %s":                        "%s comentou em %s: %s",
+	"%s approved #%s: %s":                           "%s aprovou #%s: %s",
+	"%s requested changes on #%s: %s":               "%s solicitou alterações em #%s: %s",
+	"%s reviewed #%s: %s":                           "%s revisou #%s: %s",
+	"%s %s release %s":                              "Release %[3]s %[2]s por %[1]s",
+	"%s starred the repository":                     "%s marcou o repositório com estrela",
+	"%s unstarred the repository":                   "%s removeu a estrela do repositório",
+	"%s forked the repository to %s":                "%s fez um fork do repositório para %s",
+	"Workflow %s #%s %s on %s":                      "Workflow %s #%s %s em %s",
+	"%s %s branch protection rule %s":               "Regra de proteção de branch %[3]s %[2]s por %[1]s",
+	"Check suite %s on %s (%s)":                     "Conjunto de verificações %s em %s (%s)",
+	"Status %s on %s: %s":                           "Status %s em %s: %s",
+	"%s %s a deployment of %s to %s":                "Implantação de %[3]s em %[4]s %[2]s por %[1]s",
+	"Deployment of %s: %s":                          "Implantação de %s: %s",
+	"%s %s discussion #%s: %s":                      "Discussão #%[3]s %[2]s por %[1]s: %[4]s",
+	"Dependabot alert %s for %s (%s)":               "Alerta do Dependabot %s para %s (%s)",
+	"Job %s of %s %s on %s":                         "Job %s de %s %s em %s",
+	"Check run %s %s on %s":                         "Verificação %s %s em %s",
+	"%s made the repository public":                 "%s tornou o repositório público",
+	"%s started watching the repository":            "%s começou a acompanhar o repositório",
+	"%s %s the repository":                          "Repositório %[2]s por %[1]s",
+	"%s %s team %s":                                 "Equipe %[3]s %[2]s por %[1]s",
+	"GitHub Pages build %s for %s":                  "Build do GitHub Pages %s para %s",
+	"Vulnerability alert %s for %s":                 "Alerta de vulnerabilidade %s para %s",
+	"Code scanning alert #%s %s: %s":                "Alerta de varredura de código #%s %s: %s",
+	"Secret scanning alert #%s %s: %s":              "Alerta de varredura de segredos #%s %s: %s",
+	"%s %s label %s":                                "Label %[3]s %[2]s por %[1]s",
+	"%s %s milestone %s":                            "Marco %[3]s %[2]s por %[1]s",
+	"%s %s collaborator %s":                         "Colaborador %[3]s %[2]s por %[1]s",
+	"%s %s %s (team %s)":                            "%[3]s %[2]s por %[1]s (equipe %[4]s)",
+	"Merge group %s for %s: %s":                     "Grupo de merge %s para %s: %s",
+	"%s %s ruleset %s":                              "Conjunto de regras %[3]s %[2]s por %[1]s",
+	"%s %s wiki page %s":                            "Página wiki %[3]s %[2]s por %[1]s",
+	"(and %s more)":                                 "(e mais %s)",
+	"Sponsorship of %s by %s %s: %s":                "Patrocínio de %s por %s %s: %s",
+	"Sponsorship of %s by a private sponsor %s: %s": "Patrocínio de %s por um patrocinador privado %s: %s",
+	"Security advisory %s %s: %s":                   "Aviso de segurança %s %s: %s",
+	"%s %s a GitHub App on %s":                      "GitHub App em %[3]s %[2]s por %[1]s",
+	"%s %s repositories of a GitHub App: %s":        "Repositórios de um GitHub App %[2]s por %[1]s: %[3]s",
+	"%s %s the GitHub App %s on %s":                 "GitHub App %[3]s %[2]s em %[4]s por %[1]s",
+	"%s %s repositories of the GitHub App %s: %s":   "Repositórios do GitHub App %[3]s %[2]s por %[1]s: %[4]s",
+	"%s %s their authorization of the GitHub App":   "Autorização do GitHub App %[2]s por %[1]s",
+	"%s %s the organization":                        "Organização %[2]s por %[1]s",
+	"Organization %s by %s: %s":                     "Organização %s por %s: %s",
+	"%s %s user %s":                                 "Usuário %[3]s %[2]s por %[1]s",
+	"%s %s project %s":                              "Projeto %[3]s %[2]s por %[1]s",
+	"%s %s %s in a project":                         "%[3]s %[2]s em um projeto por %[1]s",
+	"%s %s package %s":                              "Pacote %[3]s %[2]s por %[1]s",
+
+	// Placeholders
+	"No URL available":             "Nenhuma URL disponível",
+	"No affected packages listed":  "Nenhum pacote afetado listado",
+	"No conclusion yet!":           "Ainda sem conclusão!",
+	"No description available":     "Nenhuma descrição disponível",
+	"No description provided.":     "Nenhuma descrição fornecida.",
+	"No due date":                  "Sem data de entrega",
+	"No errors yet!":               "Nenhum erro até agora!",
+	"No permissions provided.":     "Nenhuma permissão fornecida.",
+	"No privacy settings set.":     "Nenhuma configuração de privacidade definida.",
+	"Read more":                    "Ler mais",
+	"No reason provided":           "Nenhum motivo fornecido",
+	"No review body":               "Revisão sem texto",
+	"No status yet!":               "Ainda sem status!",
+	"*none*":                       "*nenhum*",
+	"No commits?":                  "Nenhum commit?",
+	"No patched version available": "Nenhuma versão corrigida disponível",
+	"Not dismissed":                "Não descartado",
+	"Not scored":                   "Sem pontuação",
+
+	// Descriptions and other sentences used in values
+	"(excluding %s)": "(exceto %s)",
+	"Adding new comments/answers is now prohibited": "Não é mais possível adicionar comentários/respostas",
+	"Discussion is now Closed: %s":                  "A discussão agora está fechada: %s",
+	"Discussion is still open for comments":         "A discussão continua aberta para comentários",
+	"Failed at: %s":                                 "Falhou em: %s",
+	"It looks like this comment has received a update that is not tracked by our systems yet!":    "Parece que este comentário recebeu uma atualização que nossos sistemas ainda não acompanham!",
+	"It looks like this discussion has received a update that is not tracked by our systems yet!": "Parece que esta discussão recebeu uma atualização que nossos sistemas ainda não acompanham!",
+	"No": "Não",
+	"This discussion has been closed and will no longer allow new comments/posts": "Esta discussão foi fechada e não permite mais novos comentários/publicações",
+	"This discussion has been moved to a new category!":                           "Esta discussão foi movida para uma nova categoria!",
+	"This discussion has been reopened":                                           "Esta discussão foi reaberta",
+	"This secret was pushed even though push protection blocked it":               "Este segredo foi enviado mesmo com a proteção de push bloqueando-o",
+	"Yes":                                  "Sim",
+	"You are free to answer/comment again": "Você pode responder/comentar novamente",
+	"changed from %s":                      "alterado de %s",
+
+	"...and %d more":                  "...e mais %d",
+	", %d distinct":                   ", %d distintos",
+	" (attempt %d)":                   " (tentativa %d)",
+	" (private)":                      " (privado)",
+	"no patch yet":                    "ainda sem patch",
+	"**%s** (%s) %s, patched in %s\n": "**%s** (%s) %s, corrigido em %s\n",
+	"Compare changes":                 "Comparar alterações",
+	"View all commits":                "Ver todos os commits",
+	// Actions, states and other words used in titles
+	"action required":         "ação necessária",
+	"added":                   "adicionado",
+	"added to repository":     "adicionado ao repositório",
+	"answered":                "respondido",
+	"approved":                "aprovado",
+	"Approved":                "Aprovado",
+	"archived":                "arquivado",
+	"assigned":                "atribuído",
+	"blocked":                 "bloqueado",
+	"branch":                  "branch",
+	"Branch":                  "Branch",
+	"cancelled":               "cancelado",
+	"category changed":        "categoria alterada",
+	"Changes Requested":       "Alterações solicitadas",
+	"checks requested":        "verificações solicitadas",
+	"closed":                  "fechado",
+	"Commented":               "Comentado",
+	"completed":               "concluído",
+	"converted to draft":      "convertido em rascunho",
+	"created":                 "criado",
+	"deleted":                 "excluído",
+	"destroyed":               "desfeito",
+	"dismissed":               "descartado",
+	"Dismissed":               "Descartado",
+	"Draft issue":             "Issue de rascunho",
+	"edited":                  "editado",
+	"error":                   "erro",
+	"failed":                  "falhou",
+	"failure":                 "falha",
+	"fixed":                   "corrigido",
+	"in progress":             "em andamento",
+	"Item":                    "Item",
+	"labeled":                 "com label",
+	"locked":                  "bloqueado",
+	"member added":            "membro adicionado",
+	"member invited":          "membro convidado",
+	"member removed":          "membro removido",
+	"merged":                  "mesclado",
+	"neutral":                 "neutro",
+	"open":                    "aberto",
+	"opened":                  "aberto",
+	"pending":                 "pendente",
+	"pinned":                  "fixado",
+	"prereleased":             "publicado como pré-release",
+	"published":               "publicado",
+	"Pull request":            "Pull request",
+	"queued":                  "na fila",
+	"ready for review":        "pronto para revisão",
+	"released":                "lançado",
+	"removed":                 "removido",
+	"removed from repository": "removido do repositório",
+	"renamed":                 "renomeado",
+	"reopened":                "reaberto",
+	"repository":              "repositório",
+	"requested":               "solicitado",
+	"rerequested":             "solicitado novamente",
+	"resolved":                "resolvido",
+	"restored":                "restaurado",
+	"review requested":        "revisão solicitada",
+	"skipped":                 "ignorado",
+	"stale":                   "obsoleto",
+	"started":                 "iniciado",
+	"succeeded":               "bem-sucedido",
+	"success":                 "sucesso",
+	"synchronize":             "sincronizado",
+	"tag":                     "tag",
+	"timed out":               "tempo esgotado",
+	"transferred":             "transferido",
+	"unarchived":              "desarquivado",
+	"unassigned":              "atribuição removida",
+	"unblocked":               "desbloqueado",
+	"unlabeled":               "label removido",
+	"unlocked":                "desbloqueado",
+	"unpinned":                "desafixado",
+	"unpublished":             "despublicado",
+	"updated":                 "atualizado",
+	"waiting":                 "aguardando",
+
+	// Field names
+	"CVSS":                                "CVSS",
+	"Commit":                              "Commit",
+	"Head SHA":                            "SHA do head",
+	"Is Production (according to github)": "Produção (segundo o GitHub)",
+	"Is Transient Environment":            "Ambiente temporário",
+	"Pull Request":                        "Pull Request",
+	"Ref":                                 "Ref",
+	"Refs":                                "Refs",
+	"Runner":                              "Runner",
+	"Sponsor":                             "Patrocinador(a)",
+	"Status":                              "Status",
+	"Tag":                                 "Tag",
+	"Team":                                "Equipe",
+	"URL":                                 "URL",
+	"Account":                             "Conta",
+	"Action":                              "Ação",
+	"Action Taken":                        "Ação tomada",
+	"Added":                               "Adicionado",
+	"Added By":                            "Adicionado por",
+	"Affected Packages":                   "Pacotes afetados",
+	"Answer Posted By":                    "Resposta publicada por",
+	"Answer Selected By":                  "Resposta escolhida por",
+	"Attempt":                             "Tentativa",
+	"Author":                              "Autor(a)",
+	"Base Ref":                            "Ref base",
+	"Blocked User":                        "Usuário bloqueado",
+	"Body":                                "Conteúdo",
+	"Category":                            "Categoria",
+	"Changes":                             "Alterações",
+	"Closed By":                           "Fechado por",
+	"Collaborator":                        "Colaborador(a)",
+	"Color":                               "Cor",
+	"Comment":                             "Comentário",
+	"Comment Author":                      "Autor(a) do comentário",
+	"Commit Sender":                       "Autor(a) do commit",
+	"Conclusion":                          "Conclusão",
+	"Context":                             "Contexto",
+	"Created By":                          "Criado por",
+	"Creator":                             "Criador(a)",
+	"Default Label?":                      "Label padrão?",
+	"Deleted By":                          "Excluído por",
+	"Description":                         "Descrição",
+	"Details":                             "Detalhes",
+	"Details URL":                         "URL de detalhes",
+	"Discussion":                          "Discussão",
+	"Discussion Locked?":                  "Discussão bloqueada?",
+	"Dismissal Details":                   "Detalhes do descarte",
+	"Due On":                              "Data de entrega",
+	"Duration":                            "Duração",
+	"Ecosystem":                           "Ecossistema",
+	"Effective":                           "Em vigor",
+	"Environment":                         "Ambiente",
+	"Environment URL":                     "URL do ambiente",
+	"Errors":                              "Erros",
+	"Event":                               "Evento",
+	"Events":                              "Eventos",
+	"Force Push":                          "Force push",
+	"Forked repo":                         "Repositório do fork",
+	"Head Commit":                         "Commit head",
+	"Identifiers":                         "Identificadores",
+	"Install":                             "Instalação",
+	"Issues":                              "Issues",
+	"Label Description":                   "Descrição do label",
+	"Label Name":                          "Nome do label",
+	"Last Commit":                         "Último commit",
+	"Location":                            "Localização",
+	"Locked By":                           "Bloqueado por",
+	"Log URL":                             "URL do log",
+	"Master Branch":                       "Branch principal",
+	"Member":                              "Membro",
+	"Membership":                          "Associação",
+	"More Information":                    "Mais informações",
+	"Name":                                "Nome",
+	"New Category":                        "Nova categoria",
+	"Opened By":                           "Aberto por",
+	"Origin Discussion":                   "Discussão de origem",
+	"Original repo":                       "Repositório original",
+	"Package":                             "Pacote",
+	"Parent Issue":                        "Issue pai",
+	"Payload":                             "Payload",
+	"Permission":                          "Permissão",
+	"Permissions":                         "Permissões",
+	"Pinned By":                           "Fixado por",
+	"Privacy":                             "Privacidade",
+	"Project":                             "Projeto",
+	"Publisher":                           "Publicado por",
+	"Pull Requests":                       "Pull Requests",
+	"Pusher":                              "Autor(a) do push",
+	"Pusher Type":                         "Tipo de push",
+	"Reason":                              "Motivo",
+	"Ref Type":                            "Tipo de ref",
+	"Release":                             "Release",
+	"Removed":                             "Removido",
+	"Repositories":                        "Repositórios",
+	"Repository":                          "Repositório",
+	"Repository Selection":                "Seleção de repositórios",
+	"Resolution Details":                  "Detalhes da resolução",
+	"Reviewer":                            "Revisor(a)",
+	"Role":                                "Função",
+	"Rule":                                "Regra",
+	"Run Number":                          "Número da execução",
+	"Secret Type":                         "Tipo de secret",
+	"Severity":                            "Severidade",
+	"State":                               "Estado",
+	"Steps":                               "Etapas",
+	"Summary":                             "Resumo",
+	"Target URL":                          "URL de destino",
+	"Task":                                "Tarefa",
+	"Team Permission":                     "Permissão da equipe",
+	"Tier":                                "Nível",
+	"Title":                               "Título",
+	"Tool":                                "Ferramenta",
+	"Triggered By":                        "Disparado por",
+	"Unlocked By":                         "Desbloqueado por",
+	"Unpinned By":                         "Desafixado por",
+	"User":                                "Usuário",
+	"Validity":                            "Validade",
+	"Version":                             "Versão",
+	"View Answer":                         "Ver resposta",
+	"View Discussion":                     "Ver discussão",
+	"Visibility":                          "Visibilidade",
+	"Vulnerabilities":                     "Vulnerabilidades",
+	"Weaknesses":                          "Fraquezas",
+	"Workflow Name":                       "Nome do workflow",
+	"⚠️ Push Protection Bypassed":         "⚠️ Proteção de push ignorada",
+
+	// Labels inside values and names of settings
+	"Admin enforced":                     "Aplicado a administradores",
+	"Advisory":                           "Aviso",
+	"After":                              "Depois",
+	"Allow deletions":                    "Permitir exclusões",
+	"Allow force pushes":                 "Permitir force pushes",
+	"Applies to":                         "Aplica-se a",
+	"Authorized actors":                  "Atores autorizados",
+	"Authorized actors only":             "Somente atores autorizados",
+	"Authorized dismissal actors only":   "Somente atores autorizados podem descartar revisões",
+	"Base":                               "Base",
+	"Base Label":                         "Label base",
+	"Before":                             "Antes",
+	"Block force pushes":                 "Bloquear force pushes",
+	"Branch name pattern":                "Padrão de nome de branch",
+	"Bypass actors":                      "Atores com exceção",
+	"Bypassed By":                        "Contornado por",
+	"CVE":                                "CVE",
+	"Commit author email pattern":        "Padrão de e-mail do autor do commit",
+	"Commit message pattern":             "Padrão de mensagem de commit",
+	"Committer email pattern":            "Padrão de e-mail do committer",
+	"Conditions changed":                 "Condições alteradas",
+	"Could be fixed by resolving":        "Pode ser corrigido resolvendo",
+	"Create protected":                   "Criação protegida",
+	"Default Branch":                     "Branch padrão",
+	"Dismiss stale reviews on push":      "Descartar revisões obsoletas no push",
+	"Dismissed By":                       "Descartado por",
+	"Dismissed Reason":                   "Motivo do descarte",
+	"Enforcement":                        "Aplicação",
+	"Field changed":                      "Campo alterado",
+	"First Patched Version":              "Primeira versão corrigida",
+	"Fixed In":                           "Corrigido em",
+	"GHSA ID":                            "ID GHSA",
+	"Head":                               "Head",
+	"Head Label":                         "Label head",
+	"Head Ref":                           "Ref head",
+	"Homepage":                           "Página inicial",
+	"Ignore approvals from contributors": "Ignorar aprovações de contribuidores",
+	"Linear history requirement":         "Exigência de histórico linear",
+	"Login":                              "Login",
+	"Manifest Path":                      "Caminho do manifesto",
+	"Merge queue requirement":            "Exigência de fila de merge",
+	"Pull request reviews requirement":   "Exigência de revisões de pull request",
+	"Require a pull request":             "Exigir um pull request",
+	"Require code scanning results":      "Exigir resultados de code scanning",
+	"Require deployments":                "Exigir deployments",
+	"Require linear history":             "Exigir histórico linear",
+	"Require merge queue":                "Exigir fila de merge",
+	"Require signed commits":             "Exigir commits assinados",
+	"Require status checks":              "Exigir verificações de status",
+	"Required approving review count":    "Número de aprovações exigidas",
+	"Required conversation resolution":   "Resolução de conversas exigida",
+	"Required deployments":               "Deployments exigidos",
+	"Required status checks":             "Verificações de status exigidas",
+	"Resolution":                         "Resolução",
+	"Resolved By":                        "Resolvido por",
+	"Restrict creations":                 "Restringir criações",
+	"Restrict deletions":                 "Restringir exclusões",
+	"Restrict updates":                   "Restringir atualizações",
+	"Rules added":                        "Regras adicionadas",
+	"Rules removed":                      "Regras removidas",
+	"Rules updated":                      "Regras atualizadas",
+	"Scope":                              "Escopo",
+	"Settings":                           "Configurações",
+	"Signature requirement":              "Exigência de assinatura",
+	"Strict status checks":               "Verificações de status estritas",
+	"Tag name pattern":                   "Padrão de nome de tag",
+	"Topics":                             "Tópicos",
+	"Target":                             "Alvo",
+	"Type":                               "Tipo",
+	"Vulnerable Version Range":           "Intervalo de versões vulneráveis",
+}
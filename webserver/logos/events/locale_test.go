@@ -0,0 +1,113 @@
+package events
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+// TestLocalePush renders a push in German and checks both the title and field names are translated
+func TestLocalePush(t *testing.T) {
+	bytes, err := os.ReadFile("testdata/push/push.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := Printer("de-DE")
+
+	messageSend, err := pushFn(bytes, p)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Localize(messageSend, p)
+
+	embed := messageSend.Embeds[0]
+
+	if !strings.HasPrefix(embed.Title, "Push auf ") {
+		t.Errorf("expected a German title, got %q", embed.Title)
+	}
+
+	for _, field := range embed.Fields {
+		if field.Name == "Pusher" {
+			t.Errorf("field name %q was not translated", field.Name)
+		}
+	}
+}
+
+// TestLocaleLabels renders events in Portuguese and checks the labels inside field values are translated
+func TestLocaleLabels(t *testing.T) {
+	for _, tt := range []struct {
+		payload string
+		evtFn   func(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error)
+		want    []string
+	}{
+		{"testdata/pull_request_review/submitted_approved.json", pullRequestReviewFn, []string{"**Base:**", "Aprovado"}},
+		{"testdata/code_scanning_alert/closed_by_user.json", codeScanningAlertFn, []string{"**Motivo:**", "**Descartado por:**"}},
+		{"testdata/push/forced.json", pushFn, []string{"[Comparar alterações]("}},
+		{"testdata/push/truncated.json", pushFn, []string{"[Ver todos os commits]("}},
+	} {
+		bytes, err := os.ReadFile(tt.payload)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		messageSend, err := tt.evtFn(bytes, Printer("pt"))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var values strings.Builder
+		for _, field := range messageSend.Embeds[0].Fields {
+			values.WriteString(field.Value + "\n")
+		}
+
+		for _, want := range tt.want {
+			if !strings.Contains(values.String(), want) {
+				t.Errorf("%s: expected %q in the field values, got %q", tt.payload, want, values.String())
+			}
+		}
+	}
+}
+
+// TestLocaleFallback checks that missing strings and unsupported locales fall back to English
+func TestLocaleFallback(t *testing.T) {
+	if got := Printer("pt").Sprintf("A string nobody translated %s", "x"); got != "A string nobody translated x" {
+		t.Errorf("missing string: got %q", got)
+	}
+
+	if got := translateAction(Printer("pt"), "some_new_action"); got != "some new action" {
+		t.Errorf("missing action: got %q", got)
+	}
+
+	for _, locale := range []string{"", "xx", "ja", "not a locale"} {
+		if got := Printer(locale).Sprintf("Push to %s on %s", "main", "a/b"); got != "Push to main on a/b" {
+			t.Errorf("locale %q: got %q", locale, got)
+		}
+	}
+
+	if got := Printer("pt-BR").Sprintf("Push to %s on %s", "main", "a/b"); got != "Push para main em a/b" {
+		t.Errorf("pt-BR: got %q", got)
+	}
+}
+
+// TestLocaleVerbs checks every translation keeps the format verbs of the English string
+func TestLocaleVerbs(t *testing.T) {
+	for locale, entries := range map[string]map[string]string{
+		"de": messagesDE,
+		"pt": messagesPT,
+	} {
+		for key, msg := range entries {
+			if strings.Count(key, "%") != strings.Count(msg, "%") {
+				t.Errorf("%s: %q has different format verbs than %q", locale, msg, key)
+			}
+		}
+	}
+}
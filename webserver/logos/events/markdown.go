@@ -0,0 +1,270 @@
+package events
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/message"
+)
+
+var (
+	mdTaskOpen     = regexp.MustCompile(`^(\s*)[-*+] \[ \] `)
+	mdTaskDone     = regexp.MustCompile(`^(\s*)[-*+] \[[xX]\] `)
+	mdLinkedImage  = regexp.MustCompile(`\[!\[([^\]]*)\]\([^)]*\)\]\(([^)\s]+)\)`)
+	mdImage        = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	mdImageTag     = regexp.MustCompile(`(?i)<img\s[^>]*>`)
+	mdImageAttr    = regexp.MustCompile(`(?i)\b(src|alt)\s*=\s*"([^"]*)"`)
+	mdSummary      = regexp.MustCompile(`(?i)<summary>(.*?)</summary>`)
+	mdDetailsTag   = regexp.MustCompile(`(?i)</?(details|summary)[^>]*>`)
+	mdLineBreak    = regexp.MustCompile(`(?i)<br\s*/?>`)
+	mdTableDivider = regexp.MustCompile(`^\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?$`)
+)
+
+// formatBody converts the GitHub flavored markdown of an issue, pull request or comment body to
+// markdown Discord can show, truncating it to limit bytes with a link to url to read the rest
+func formatBody(p *message.Printer, body string, limit int, url string) string {
+	body = convertMarkdown(body)
+
+	if len(body) <= limit {
+		return body
+	}
+
+	return truncateMarkdown(p, body, limit, url)
+}
+
+// convertMarkdown strips HTML comments and details blocks and converts task lists, images and
+// tables, leaving code blocks untouched
+func convertMarkdown(body string) string {
+	var lines []string
+	var fence string
+	var inComment bool
+
+	for _, line := range strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n") {
+		if fence != "" {
+			lines = append(lines, line)
+
+			if closesFence(line, fence) {
+				fence = ""
+			}
+
+			continue
+		}
+
+		stripped, comment := stripComments(line, inComment)
+		inComment = comment
+
+		// Drop lines that only held a comment, such as the hints in PR templates
+		if stripped != line && strings.TrimSpace(stripped) == "" {
+			continue
+		}
+
+		if fence = openFence(stripped); fence != "" {
+			lines = append(lines, stripped)
+			continue
+		}
+
+		converted, ok := convertLine(stripped)
+
+		if !ok {
+			continue
+		}
+
+		// Collapse runs of blank lines into one
+		if converted == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+			continue
+		}
+
+		lines = append(lines, converted)
+	}
+
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n ")
+}
+
+// convertLine converts a line outside of a code block, returning false if the line should be dropped
+func convertLine(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+
+	// Discord has no tables, show rows as pipe separated cells instead
+	if strings.HasPrefix(trimmed, "|") {
+		if mdTableDivider.MatchString(trimmed) {
+			return "", false
+		}
+
+		cells := strings.Split(strings.Trim(trimmed, "|"), "|")
+
+		for i := range cells {
+			cells[i] = strings.TrimSpace(cells[i])
+		}
+
+		line = strings.Join(cells, " | ")
+	}
+
+	line = mdTaskOpen.ReplaceAllString(line, "${1}⬜ ")
+	line = mdTaskDone.ReplaceAllString(line, "${1}✅ ")
+
+	line = mdLinkedImage.ReplaceAllStringFunc(line, func(s string) string {
+		match := mdLinkedImage.FindStringSubmatch(s)
+		return imageLink(match[1], match[2])
+	})
+
+	line = mdImage.ReplaceAllStringFunc(line, func(s string) string {
+		match := mdImage.FindStringSubmatch(s)
+		return imageLink(match[1], match[2])
+	})
+
+	line = mdImageTag.ReplaceAllStringFunc(line, func(s string) string {
+		var src, alt string
+
+		for _, attr := range mdImageAttr.FindAllStringSubmatch(s, -1) {
+			if strings.EqualFold(attr[1], "src") {
+				src = attr[2]
+			} else {
+				alt = attr[2]
+			}
+		}
+
+		if src == "" {
+			return ""
+		}
+
+		return imageLink(alt, src)
+	})
+
+	line = mdSummary.ReplaceAllString(line, "**$1**")
+	line = mdLineBreak.ReplaceAllString(line, "\n")
+
+	if tagless := mdDetailsTag.ReplaceAllString(line, ""); tagless != line {
+		// Drop lines that only opened or closed a details block
+		if strings.TrimSpace(tagless) == "" {
+			return "", false
+		}
+
+		line = tagless
+	}
+
+	return line, true
+}
+
+// imageLink returns a link to an image, as Discord does not show images inline in embeds
+func imageLink(alt, url string) string {
+	if alt == "" {
+		alt = "image"
+	}
+
+	return "[🖼️ " + alt + "](" + url + ")"
+}
+
+// stripComments removes HTML comments from a line, inComment is whether the line starts inside
+// a comment opened on a previous line
+func stripComments(line string, inComment bool) (string, bool) {
+	var out strings.Builder
+
+	for {
+		if inComment {
+			end := strings.Index(line, "-->")
+
+			if end == -1 {
+				return out.String(), true
+			}
+
+			line = line[end+3:]
+			inComment = false
+		}
+
+		start := strings.Index(line, "<!--")
+
+		if start == -1 {
+			out.WriteString(line)
+			return out.String(), false
+		}
+
+		out.WriteString(line[:start])
+		line = line[start+4:]
+		inComment = true
+	}
+}
+
+// openFence returns the marker (such as ``` or ~~~~) if the line opens a code block
+func openFence(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+
+	if len(line)-len(trimmed) > 3 {
+		return ""
+	}
+
+	for _, marker := range []string{"```", "~~~"} {
+		if strings.HasPrefix(trimmed, marker) {
+			return trimmed[:len(trimmed)-len(strings.TrimLeft(trimmed, marker[:1]))]
+		}
+	}
+
+	return ""
+}
+
+// closesFence returns whether the line closes a code block opened with fence
+func closesFence(line, fence string) bool {
+	trimmed := strings.TrimLeft(line, " ")
+
+	return strings.HasPrefix(trimmed, fence) && strings.TrimSpace(strings.TrimLeft(trimmed, fence[:1])) == ""
+}
+
+// truncateMarkdown cuts body down to limit bytes at a paragraph or word boundary, closing any
+// code block that was cut in half and linking to url to read the rest
+func truncateMarkdown(p *message.Printer, body string, limit int, url string) string {
+	var more = "..."
+	if url != "" {
+		more = "... [" + p.Sprintf("Read more") + "](" + url + ")"
+	}
+
+	for cut := limit - len(more); cut > 0; {
+		text := cutAtBoundary(body, cut)
+
+		var fence string
+		for _, line := range strings.Split(text, "\n") {
+			switch {
+			case fence == "":
+				fence = openFence(line)
+			case closesFence(line, fence):
+				fence = ""
+			}
+		}
+
+		if fence != "" {
+			text += "\n" + fence + "\n"
+		}
+
+		if len(text)+len(more) <= limit {
+			return text + more
+		}
+
+		// Closing the code block did not fit, cut further back
+		cut -= len(text) + len(more) - limit
+	}
+
+	return more
+}
+
+// cutAtBoundary cuts s down to at most n bytes, preferring the end of a paragraph and then the
+// end of a word as long as that does not lose more than half of the text
+func cutAtBoundary(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	s = s[:n]
+
+	if i := strings.LastIndex(s, "\n\n"); i > n/2 {
+		return strings.TrimRight(s[:i], "\n ")
+	}
+
+	if i := strings.LastIndexAny(s, " \n"); i > n/2 {
+		return strings.TrimRight(s[:i], "\n ")
+	}
+
+	return s
+}
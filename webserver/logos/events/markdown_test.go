@@ -0,0 +1,74 @@
+package events
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatBody(t *testing.T) {
+	p := Printer("en")
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "comments",
+			body: "<!-- hint -->\nFixes #1 <!-- inline -->\n<!--\nmulti\nline\n-->\nDone",
+			want: "Fixes #1 \nDone",
+		},
+		{
+			name: "code fences are left alone",
+			body: "~~~~\n<!-- kept -->\n```\n- [x] kept\n~~~~\n- [x] done",
+			want: "~~~~\n<!-- kept -->\n```\n- [x] kept\n~~~~\n✅ done",
+		},
+		{
+			name: "images",
+			body: `[![badge](https://img.shields.io/x.svg)](https://ci.example) ![](https://a/b.png) <img src="https://a/c.png">`,
+			want: "[🖼️ badge](https://ci.example) [🖼️ image](https://a/b.png) [🖼️ image](https://a/c.png)",
+		},
+		{
+			name: "blank lines",
+			body: "a\r\n\r\n\r\n\r\nb\n\n",
+			want: "a\n\nb",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := formatBody(p, tt.body, 1000, ""); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFormatBodyTruncate(t *testing.T) {
+	p := Printer("en")
+
+	// Paragraphs are preferred over words
+	got := formatBody(p, strings.Repeat("word ", 50)+"\n\n"+strings.Repeat("word ", 50), 300, "https://example.com")
+
+	if want := strings.TrimSpace(strings.Repeat("word ", 50)) + "... [Read more](https://example.com)"; got != want {
+		t.Errorf("paragraph: got %q", got)
+	}
+
+	// Words are never cut in half
+	got = formatBody(p, strings.Repeat("abcdefgh ", 100), 100, "")
+
+	if !strings.HasSuffix(got, "abcdefgh...") || len(got) > 100 {
+		t.Errorf("word: got %q", got)
+	}
+
+	// Code blocks cut in half are closed
+	for limit := 40; limit < 400; limit += 7 {
+		got = formatBody(p, "Trace:\n\n```go\n"+strings.Repeat("main.go:12 +0x1d\n", 30)+"```", limit, "https://example.com")
+
+		if len(got) > limit {
+			t.Fatalf("limit %d: got %d bytes", limit, len(got))
+		}
+
+		if strings.Count(got, "```")%2 != 0 {
+			t.Fatalf("limit %d: unclosed code block in %q", limit, got)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package events
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func memberFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh MemberEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var color int
+	var title string
+	switch gh.Action {
+	case "added":
+		color = colorSuccess
+		title = p.Sprintf("%s added as a collaborator on %s", gh.Member.Login, gh.Repository.FullName)
+	case "removed":
+		color = colorFailure
+		title = p.Sprintf("%s removed as a collaborator from %s", gh.Member.Login, gh.Repository.FullName)
+	default:
+		color = colorWarning
+		title = p.Sprintf("Collaborator %s %s on %s", gh.Member.Login, translateAction(p, gh.Action), gh.Repository.FullName)
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Collaborator",
+			Value:  gh.Member.Link(),
+			Inline: true,
+		},
+		{
+			Name:   "User",
+			Value:  gh.Sender.Link(),
+			Inline: true,
+		},
+	}
+
+	changes := gh.Changes.Permission.Line(p, "Permission", "") + gh.Changes.RoleName.Line(p, "Role", "")
+
+	// Older payloads only send the old permission and not the new one
+	if gh.Changes.Permission == nil && gh.Changes.OldPermission != nil {
+		changes += label(p, "Permission") + " " + p.Sprintf("changed from %s", gh.Changes.OldPermission.From) + "\n"
+	}
+
+	if changes != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Changes",
+			Value: changes,
+		})
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  color,
+				URL:    gh.Repository.HTMLURL,
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  title,
+				Fields: fields,
+			},
+		},
+	}, nil
+}
+
+func memberSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh MemberEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s collaborator %s", gh.Sender.Login, translateAction(p, gh.Action), gh.Member.Login)
+
+	return summaryLine(gh.Repository.FullName, text, gh.Member.HTMLURL), nil
+}
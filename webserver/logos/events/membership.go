@@ -0,0 +1,100 @@
+package events
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func membershipFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh MembershipEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var color int
+	var title string
+	if gh.Action == "removed" {
+		color = colorFailure
+		title = p.Sprintf("%s removed from team %s", gh.Member.Login, gh.Team.Name)
+	} else {
+		color = colorSuccess
+		title = p.Sprintf("%s added to team %s", gh.Member.Login, gh.Team.Name)
+	}
+
+	if gh.Organization.Login != "" {
+		title += p.Sprintf(" in %s", gh.Organization.Login)
+	}
+
+	// Older payloads do not include a link to the team
+	var teamURL = gh.Team.HTMLURL
+	if teamURL == "" && gh.Organization.Login != "" && gh.Team.Slug != "" {
+		teamURL = "https://github.com/orgs/" + gh.Organization.Login + "/teams/" + gh.Team.Slug
+	}
+
+	var team = gh.Team.Name
+	if teamURL != "" {
+		team = "[" + gh.Team.Name + "](" + teamURL + ")"
+	}
+
+	if gh.Team.Deleted {
+		team += " (deleted)"
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Member",
+			Value:  gh.Member.Link(),
+			Inline: true,
+		},
+		{
+			Name:   "Team",
+			Value:  team,
+			Inline: true,
+		},
+	}
+
+	if gh.Team.Permission != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Team Permission",
+			Value:  gh.Team.Permission,
+			Inline: true,
+		})
+	}
+
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:   "User",
+		Value:  gh.Sender.Link(),
+		Inline: true,
+	})
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  color,
+				URL:    teamURL,
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  title,
+				Fields: fields,
+			},
+		},
+	}, nil
+}
+
+func membershipSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh MembershipEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s %s (team %s)", gh.Sender.Login, translateAction(p, gh.Action), gh.Member.Login, gh.Team.Name)
+
+	return summaryLine(gh.Organization.Login, text, gh.Team.HTMLURL), nil
+}
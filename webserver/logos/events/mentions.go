@@ -0,0 +1,159 @@
+package events
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// An @mention of a GitHub user, logins are alphanumeric with single hyphens and at most 39 characters
+var mdMention = regexp.MustCompile(`(?:^|[^\w/` + "`" + `@])@([A-Za-z0-9](?:-?[A-Za-z0-9]){0,38})\b`)
+
+// Inline code, which can hold @ without meaning a mention
+var mdInlineCode = regexp.MustCompile("`[^`\n]*`")
+
+type mentionsEvent struct {
+	Action            string `json:"action"`
+	Sender            User   `json:"sender"`
+	RequestedReviewer User   `json:"requested_reviewer"`
+	Assignee          User   `json:"assignee"`
+	Issue             struct {
+		Body string `json:"body"`
+	} `json:"issue"`
+	PullRequest struct {
+		Body               string `json:"body"`
+		RequestedReviewers []User `json:"requested_reviewers"`
+	} `json:"pull_request"`
+	Discussion struct {
+		Body string `json:"body"`
+	} `json:"discussion"`
+	Review struct {
+		Body string `json:"body"`
+	} `json:"review"`
+	Comment struct {
+		Body string `json:"body"`
+	} `json:"comment"`
+}
+
+// Mentions returns the lowercased GitHub logins an event should ping: requested reviewers,
+// assignees and users @mentioned in a newly opened issue, pull request, discussion or comment.
+// The sender of the event is never included
+func Mentions(event string, bytes []byte) ([]string, error) {
+	var gh mentionsEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var logins []string
+
+	switch event + "." + gh.Action {
+	case "pull_request.review_requested":
+		logins = append(logins, gh.RequestedReviewer.Login)
+	case "issues.assigned", "pull_request.assigned":
+		logins = append(logins, gh.Assignee.Login)
+	case "issues.opened":
+		logins = append(logins, bodyMentions(gh.Issue.Body)...)
+	case "pull_request.opened":
+		for _, reviewer := range gh.PullRequest.RequestedReviewers {
+			logins = append(logins, reviewer.Login)
+		}
+
+		logins = append(logins, bodyMentions(gh.PullRequest.Body)...)
+	case "discussion.created":
+		logins = append(logins, bodyMentions(gh.Discussion.Body)...)
+	case "pull_request_review.submitted":
+		logins = append(logins, bodyMentions(gh.Review.Body)...)
+	case "issue_comment.created", "commit_comment.created", "pull_request_review_comment.created", "discussion_comment.created":
+		logins = append(logins, bodyMentions(gh.Comment.Body)...)
+	}
+
+	var seen = map[string]bool{
+		"":                               true,
+		strings.ToLower(gh.Sender.Login): true,
+	}
+
+	var mentions []string
+	for _, login := range logins {
+		login = strings.ToLower(login)
+
+		if !seen[login] {
+			seen[login] = true
+			mentions = append(mentions, login)
+		}
+	}
+
+	return mentions, nil
+}
+
+// bodyMentions returns the logins @mentioned in a markdown body, ignoring code and HTML comments
+func bodyMentions(body string) []string {
+	var logins []string
+	var fence string
+	var inComment bool
+
+	for _, line := range strings.Split(body, "\n") {
+		if fence != "" {
+			if closesFence(line, fence) {
+				fence = ""
+			}
+
+			continue
+		}
+
+		line, inComment = stripComments(line, inComment)
+
+		if fence = openFence(line); fence != "" {
+			continue
+		}
+
+		line = mdInlineCode.ReplaceAllString(line, "")
+
+		for _, match := range mdMention.FindAllStringSubmatchIndex(line, -1) {
+			// Team mentions (@org/team) are not users
+			if match[3] < len(line) && line[match[3]] == '/' {
+				continue
+			}
+
+			logins = append(logins, line[match[2]:match[3]])
+		}
+	}
+
+	return logins
+}
+
+// AddMentions pings roles (from event modifiers) and then users in the content of a rendered
+// message, and only allows those to be mentioned so that a message can never ping @everyone
+func AddMentions(messageSend *discordgo.MessageSend, roleIDs []string, userIDs []string) {
+	// Discord allows at most 100 roles and 100 users to be mentioned
+	if len(roleIDs) > 100 {
+		roleIDs = roleIDs[:100]
+	}
+
+	if len(userIDs) > 100 {
+		userIDs = userIDs[:100]
+	}
+
+	var mentions []string
+	for _, id := range roleIDs {
+		mentions = append(mentions, "<@&"+id+">")
+	}
+
+	for _, id := range userIDs {
+		mentions = append(mentions, "<@"+id+">")
+	}
+
+	if len(mentions) > 0 {
+		messageSend.Content = strings.TrimSpace(strings.Join(mentions, " ") + " " + messageSend.Content)
+	}
+
+	messageSend.AllowedMentions = &discordgo.MessageAllowedMentions{
+		Parse: []discordgo.AllowedMentionType{},
+		Roles: roleIDs,
+		Users: userIDs,
+	}
+}
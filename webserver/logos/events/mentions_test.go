@@ -0,0 +1,52 @@
+package events
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMentions(t *testing.T) {
+	tests := []struct {
+		event   string
+		payload string
+		want    []string
+	}{
+		{
+			event:   "pull_request",
+			payload: `{"action": "review_requested", "sender": {"login": "octocat"}, "requested_reviewer": {"login": "Hubot"}}`,
+			want:    []string{"hubot"},
+		},
+		{
+			event:   "issues",
+			payload: `{"action": "assigned", "sender": {"login": "octocat"}, "assignee": {"login": "octocat"}}`,
+			want:    nil,
+		},
+		{
+			event:   "pull_request",
+			payload: `{"action": "opened", "sender": {"login": "octocat"}, "pull_request": {"requested_reviewers": [{"login": "alice"}], "body": "cc @Bob @alice @octocat"}}`,
+			want:    []string{"alice", "bob"},
+		},
+		{
+			event:   "issue_comment",
+			payload: "{\"action\": \"created\", \"comment\": {\"body\": \"ping @a-b, mail me@example.com, team @org/team, `@code`\\n<!-- @hidden -->\\n```\\n@fenced\\n```\\n(@c)\"}}",
+			want:    []string{"a-b", "c"},
+		},
+		{
+			event:   "issue_comment",
+			payload: `{"action": "edited", "comment": {"body": "@alice"}}`,
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := Mentions(tt.event, []byte(tt.payload))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s %s: got %v, want %v", tt.event, tt.payload, got, tt.want)
+		}
+	}
+}
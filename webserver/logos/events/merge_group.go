@@ -0,0 +1,113 @@
+package events
+
+import (
+	"regexp"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+// Merge queue branches are named gh-readonly-queue/<base>/pr-<number>-<sha>
+var mergeGroupPR = regexp.MustCompile(`/pr-(\d+)-[0-9a-f]+$`)
+
+func mergeGroupFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh MergeGroupEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var baseRef = shortRef(gh.MergeGroup.BaseRef)
+
+	var color int
+	var title string
+	switch gh.Action {
+	case "checks_requested":
+		color = colorWarning
+		title = p.Sprintf("Merge queue checks requested on %s", gh.Repository.FullName)
+	case "destroyed":
+		switch gh.Reason {
+		case "merged":
+			color = colorSuccess
+		default:
+			color = colorFailure
+		}
+
+		title = p.Sprintf("Merge group %s on %s", translateAction(p, gh.Reason), gh.Repository.FullName)
+	default:
+		color = colorWarning
+		title = p.Sprintf("Merge group %s on %s", translateAction(p, gh.Action), gh.Repository.FullName)
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Base Ref",
+			Value:  baseRef,
+			Inline: true,
+		},
+	}
+
+	if len(gh.MergeGroup.HeadSHA) >= 7 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Head SHA",
+			Value:  gh.Repository.Commit(gh.MergeGroup.HeadSHA),
+			Inline: true,
+		})
+	}
+
+	if match := mergeGroupPR.FindStringSubmatch(gh.MergeGroup.HeadRef); match != nil {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Pull Request",
+			Value:  "[#" + match[1] + "](" + gh.Repository.HTMLURL + "/pull/" + match[1] + ")",
+			Inline: true,
+		})
+	}
+
+	if gh.Action == "destroyed" && gh.Reason != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Reason",
+			Value:  gh.Reason,
+			Inline: true,
+		})
+	}
+
+	var description = gh.MergeGroup.HeadCommit.Message
+	if len(description) > 996 {
+		description = description[:996] + "..."
+	}
+
+	var embed = &discordgo.MessageEmbed{
+		Color:       color,
+		URL:         gh.Repository.HTMLURL + "/queue/" + baseRef,
+		Title:       title,
+		Description: description,
+		Fields:      fields,
+	}
+
+	// Merge groups are created by GitHub itself and may not have a sender
+	if gh.Sender.Login != "" {
+		embed.Author = gh.Sender.AuthorEmbed()
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{embed},
+	}, nil
+}
+
+func mergeGroupSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh MergeGroupEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("Merge group %s for %s: %s", translateAction(p, gh.Action), shortRef(gh.MergeGroup.BaseRef), snippet(gh.MergeGroup.HeadCommit.Message, 100))
+
+	return summaryLine(gh.Repository.FullName, text, gh.Repository.HTMLURL+"/queue/"+shortRef(gh.MergeGroup.BaseRef)), nil
+}
@@ -0,0 +1,123 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func milestoneFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh MilestoneEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var color int
+	switch gh.Action {
+	case "created", "opened":
+		color = colorSuccess
+	case "closed", "deleted":
+		color = colorFailure
+	default:
+		color = colorWarning
+	}
+
+	var description = gh.Milestone.Description
+	if len(description) > 1000 {
+		description = description[:1000] + "..."
+	}
+
+	if description == "" {
+		description = p.Sprintf("No description provided.")
+	}
+
+	var progress = fmt.Sprintf("%d open, %d closed", gh.Milestone.OpenIssues, gh.Milestone.ClosedIssues)
+
+	if total := gh.Milestone.OpenIssues + gh.Milestone.ClosedIssues; total > 0 {
+		progress += fmt.Sprintf(" (%d%% complete)", gh.Milestone.ClosedIssues*100/total)
+	}
+
+	var dueOn = formatDate(gh.Milestone.DueOn)
+	if dueOn == "" {
+		dueOn = p.Sprintf("No due date")
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "State",
+			Value:  gh.Milestone.State,
+			Inline: true,
+		},
+		{
+			Name:   "Due On",
+			Value:  dueOn,
+			Inline: true,
+		},
+		{
+			Name:   "Issues",
+			Value:  progress,
+			Inline: true,
+		},
+		{
+			Name:  "Description",
+			Value: description,
+		},
+		{
+			Name:   "Creator",
+			Value:  gh.Milestone.Creator.Link(),
+			Inline: true,
+		},
+	}
+
+	var dueOnChange = gh.Changes.DueOn
+	if dueOnChange != nil {
+		dueOnChange.From = formatDate(dueOnChange.From)
+	}
+
+	changes := gh.Changes.Title.Line(p, "Title", gh.Milestone.Title) +
+		gh.Changes.Description.Line(p, "Description", gh.Milestone.Description) +
+		dueOnChange.Line(p, "Due On", formatDate(gh.Milestone.DueOn))
+
+	if len(changes) > 1000 {
+		changes = changes[:1000] + "..."
+	}
+
+	if changes != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Changes",
+			Value: changes,
+		})
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  color,
+				URL:    gh.Milestone.HTMLURL,
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  p.Sprintf("Milestone %s %s on %s", gh.Milestone.Title, translateAction(p, gh.Action), gh.Repository.FullName),
+				Fields: fields,
+			},
+		},
+	}, nil
+}
+
+func milestoneSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh MilestoneEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s milestone %s", gh.Sender.Login, translateAction(p, gh.Action), gh.Milestone.Title)
+
+	return summaryLine(gh.Repository.FullName, text, gh.Milestone.HTMLURL), nil
+}
@@ -0,0 +1,65 @@
+package events
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func orgBlockFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh OrgBlockEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var color int
+	var title string
+	if gh.Action == "unblocked" {
+		color = colorSuccess
+		title = p.Sprintf("%s unblocked from %s", gh.BlockedUser.Login, gh.Organization.Login)
+	} else {
+		color = colorFailure
+		title = p.Sprintf("%s blocked from %s", gh.BlockedUser.Login, gh.Organization.Login)
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  color,
+				URL:    "https://github.com/" + gh.Organization.Login,
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  title,
+				Fields: []*discordgo.MessageEmbedField{
+					{
+						Name:   "Blocked User",
+						Value:  gh.BlockedUser.Link(),
+						Inline: true,
+					},
+					{
+						Name:   "User",
+						Value:  gh.Sender.Link(),
+						Inline: true,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func orgBlockSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh OrgBlockEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s user %s", gh.Sender.Login, translateAction(p, gh.Action), gh.BlockedUser.Login)
+
+	return summaryLine(gh.Organization.Login, text, gh.BlockedUser.HTMLURL), nil
+}
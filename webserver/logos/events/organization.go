@@ -0,0 +1,120 @@
+package events
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func organizationFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh OrganizationEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var color int
+	var title string
+	var fields []*discordgo.MessageEmbedField
+
+	switch gh.Action {
+	case "member_invited":
+		color = colorSuccess
+
+		var invitee = gh.Invitation.Login
+		if invitee == "" {
+			invitee = gh.Invitation.Email
+		}
+
+		title = p.Sprintf("%s invited to %s", invitee, gh.Organization.Login)
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Role",
+			Value:  strings.ReplaceAll(gh.Invitation.Role, "_", " "),
+			Inline: true,
+		})
+	case "member_added":
+		color = colorSuccess
+		title = p.Sprintf("%s joined %s", gh.Membership.User.Login, gh.Organization.Login)
+	case "member_removed":
+		color = colorFailure
+		title = p.Sprintf("%s removed from %s", gh.Membership.User.Login, gh.Organization.Login)
+	case "renamed":
+		color = colorWarning
+		title = p.Sprintf("Organization renamed to %s", gh.Organization.Login)
+	case "deleted":
+		color = colorFailure
+		title = p.Sprintf("Organization %s deleted", gh.Organization.Login)
+	default:
+		color = colorWarning
+		title = p.Sprintf("Organization %s %s", gh.Organization.Login, translateAction(p, gh.Action))
+	}
+
+	// Invitations are sent with the inviter's membership, which is not of interest here
+	if gh.Membership.User.Login != "" && gh.Action != "member_invited" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Member",
+			Value:  gh.Membership.User.Link(),
+			Inline: true,
+		}, &discordgo.MessageEmbedField{
+			Name:   "Membership",
+			Value:  gh.Membership.Role + " (" + gh.Membership.State + ")",
+			Inline: true,
+		})
+	}
+
+	if changes := gh.Changes.Login.Line(p, "Login", gh.Organization.Login); changes != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Changes",
+			Value: changes,
+		})
+	}
+
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:   "User",
+		Value:  gh.Sender.Link(),
+		Inline: true,
+	})
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  color,
+				URL:    "https://github.com/" + gh.Organization.Login,
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  title,
+				Fields: fields,
+			},
+		},
+	}, nil
+}
+
+func organizationSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh OrganizationEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var member = gh.Membership.User.Login
+	if member == "" {
+		member = gh.Invitation.Login
+	}
+
+	if member == "" {
+		member = gh.Invitation.Email
+	}
+
+	var text = p.Sprintf("%s %s the organization", gh.Sender.Login, translateAction(p, gh.Action))
+	if member != "" {
+		text = p.Sprintf("Organization %s by %s: %s", translateAction(p, gh.Action), gh.Sender.Login, member)
+	}
+
+	return summaryLine(gh.Organization.Login, text, "https://github.com/"+gh.Organization.Login), nil
+}
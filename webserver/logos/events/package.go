@@ -0,0 +1,206 @@
+package events
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+// Tag returns the tag a package version was published under, if any
+func (v PackageVersion) Tag() string {
+	switch {
+	case v.ContainerMetadata.Tag.Name != "":
+		return v.ContainerMetadata.Tag.Name
+	case v.Release.TagName != "":
+		return v.Release.TagName
+	default:
+		return v.TagName
+	}
+}
+
+// InstallCommand returns the command to install a package version, using the command GitHub
+// sends where possible and deriving one from the ecosystem otherwise
+func (p Package) InstallCommand() string {
+	v := p.PackageVersion
+
+	if v.InstallationCommand != "" {
+		return v.InstallationCommand
+	}
+
+	var namespace = p.Namespace
+	if namespace == "" {
+		namespace = p.Owner.Login
+	}
+
+	var version = v.Version
+	if version == "" {
+		version = v.Name
+	}
+
+	var ecosystem = p.Ecosystem
+	if ecosystem == "" {
+		ecosystem = p.PackageType
+	}
+
+	switch strings.ToLower(ecosystem) {
+	case "npm":
+		return "npm install @" + strings.ToLower(namespace) + "/" + p.Name + "@" + version
+	case "container", "docker":
+		if v.PackageURL != "" {
+			return "docker pull " + v.PackageURL
+		}
+
+		var tag = v.Tag()
+		if tag == "" {
+			tag = version
+		}
+
+		return "docker pull ghcr.io/" + strings.ToLower(namespace) + "/" + p.Name + ":" + tag
+	case "rubygems":
+		return "gem install " + p.Name + " --version " + version
+	case "nuget":
+		return "dotnet add package " + p.Name + " --version " + version
+	}
+
+	return ""
+}
+
+// packageMessage renders a package or registry_package event
+func packageMessage(p *message.Printer, action string, repo Repository, sender User, pkg Package) *discordgo.MessageSend {
+	var color int
+	switch action {
+	case "published":
+		color = colorSuccess
+	default:
+		color = colorWarning
+	}
+
+	var ecosystem = pkg.Ecosystem
+	if ecosystem == "" {
+		ecosystem = pkg.PackageType
+	}
+
+	var version = pkg.PackageVersion.Version
+	if version == "" {
+		version = pkg.PackageVersion.Name
+	}
+
+	// Container versions are image digests, which are better known by their tag
+	var title = version
+	if tag := pkg.PackageVersion.ContainerMetadata.Tag.Name; tag != "" {
+		title = tag
+	}
+
+	if strings.HasPrefix(version, "sha256:") && len(version) > 19 {
+		version = version[:19]
+	}
+
+	var owner = repo.FullName
+	if owner == "" {
+		owner = pkg.Owner.Login
+	}
+
+	var url = pkg.PackageVersion.HTMLURL
+	if url == "" {
+		url = pkg.HTMLURL
+	}
+
+	var publisher = pkg.PackageVersion.Author
+	if publisher.Login == "" {
+		publisher = sender
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Package",
+			Value:  "[" + pkg.Name + "](" + pkg.HTMLURL + ")",
+			Inline: true,
+		},
+		{
+			Name:   "Ecosystem",
+			Value:  ecosystem,
+			Inline: true,
+		},
+		{
+			Name:   "Version",
+			Value:  version,
+			Inline: true,
+		},
+	}
+
+	if tag := pkg.PackageVersion.Tag(); tag != "" && tag != pkg.PackageVersion.Version {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Tag",
+			Value:  tag,
+			Inline: true,
+		})
+	}
+
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:   "Publisher",
+		Value:  publisher.Link(),
+		Inline: true,
+	})
+
+	if install := pkg.InstallCommand(); install != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Install",
+			Value: "```\n" + install + "\n```",
+		})
+	}
+
+	var description = pkg.Description
+	if len(description) > 996 {
+		description = description[:996] + "..."
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:       color,
+				URL:         url,
+				Author:      sender.AuthorEmbed(),
+				Title:       p.Sprintf("📦 Package %s %s %s on %s", pkg.Name, title, translateAction(p, action), owner),
+				Description: description,
+				Fields:      fields,
+			},
+		},
+	}
+}
+
+func packageFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh PackageEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	return packageMessage(p, gh.Action, gh.Repository, gh.Sender, gh.Package), nil
+}
+
+func packageSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh PackageEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, packageLine(p, gh.Action, gh.Sender, gh.Package), gh.Package.HTMLURL), nil
+}
+
+// packageLine is the one-line summary shared by package and registry_package events
+func packageLine(p *message.Printer, action string, sender User, pkg Package) string {
+	var name = pkg.Name
+	if tag := pkg.PackageVersion.Tag(); tag != "" {
+		name += ":" + tag
+	}
+
+	return p.Sprintf("%s %s package %s", sender.Login, translateAction(p, action), name)
+}
@@ -2,26 +2,12 @@ package events
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type PageBuildEvent struct {
-	Build struct {
-		Commit    string    `json:"commit"`
-		CreatedAt time.Time `json:"created_at"`
-		Duration  int       `json:"duration"`
-		Error     struct {
-			Message string `json:"message"`
-		} `json:"error"`
-		Status string `json:"status"`
-	} `json:"build"`
-	Repo   Repository `json:"repository"`
-	Sender User       `json:"sender"`
-}
-
-func pageBuildFn(bytes []byte) (*discordgo.MessageSend, error) {
+func pageBuildFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh PageBuildEvent
 
 	// Unmarshal the JSON into our struct
@@ -31,14 +17,24 @@ func pageBuildFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
+	var color int
+	switch gh.Build.Status {
+	case "built":
+		color = colorSuccess
+	case "errored":
+		color = colorFailure
+	default:
+		color = colorWarning
+	}
+
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:     colorGreen,
-				URL:       gh.Repo.HTMLURL,
+				Color:     color,
+				URL:       gh.Repository.HTMLURL,
 				Author:    gh.Sender.AuthorEmbed(),
-				Title:     "Page build: " + gh.Repo.FullName,
-				Timestamp: gh.Build.CreatedAt.Format(time.RFC3339),
+				Title:     p.Sprintf("Page build: %s", gh.Repository.FullName),
+				Timestamp: gh.Build.CreatedAt,
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:  "User",
@@ -46,7 +42,7 @@ func pageBuildFn(bytes []byte) (*discordgo.MessageSend, error) {
 					},
 					{
 						Name:  "Commit",
-						Value: gh.Repo.Commit(gh.Build.Commit),
+						Value: gh.Repository.Commit(gh.Build.Commit),
 					},
 					{
 						Name: "Duration",
@@ -61,7 +57,7 @@ func pageBuildFn(bytes []byte) (*discordgo.MessageSend, error) {
 						Name: "Errors",
 						Value: func() string {
 							if gh.Build.Error.Message == "" {
-								return "No errors yet!"
+								return p.Sprintf("No errors yet!")
 							}
 							return gh.Build.Error.Message
 						}(),
@@ -82,3 +78,18 @@ func pageBuildFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func pageBuildSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh PageBuildEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("GitHub Pages build %s for %s", translateAction(p, gh.Build.Status), shortSHA(gh.Build.Commit))
+
+	return summaryLine(gh.Repository.FullName, text, gh.Repository.HTMLURL), nil
+}
@@ -0,0 +1,101 @@
+package events
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Palette holds embed colors by what they mean. Renderers always use DefaultPalette, and
+// ApplyPalette swaps in the colors a guild picked
+type Palette struct {
+	// Something was created or passed
+	Success int
+
+	// Something failed, was deleted or is a serious alert
+	Failure int
+
+	// Something changed or is still pending
+	Warning int
+
+	// Something was closed, cancelled or skipped
+	Neutral int
+
+	// Activity that is neither good nor bad, such as comments and stars
+	Info int
+
+	// A high or critical security alert that is still open
+	Severe int
+}
+
+var DefaultPalette = Palette{
+	Success: 0x00ff1a,
+	Failure: 0xff0000,
+	Warning: 0xffff00,
+	Neutral: 0x99aab5,
+	Info:    0x3498db,
+	Severe:  0x992d22,
+}
+
+var (
+	colorSuccess = DefaultPalette.Success
+	colorFailure = DefaultPalette.Failure
+	colorWarning = DefaultPalette.Warning
+	colorNeutral = DefaultPalette.Neutral
+	colorInfo    = DefaultPalette.Info
+	colorSevere  = DefaultPalette.Severe
+)
+
+// ApplyPalette recolors a rendered message from the default palette to palette. Colors that
+// are not in the default palette are left as is
+func ApplyPalette(messageSend *discordgo.MessageSend, palette Palette) {
+	for _, embed := range messageSend.Embeds {
+		switch embed.Color {
+		case DefaultPalette.Success:
+			embed.Color = palette.Success
+		case DefaultPalette.Failure:
+			embed.Color = palette.Failure
+		case DefaultPalette.Warning:
+			embed.Color = palette.Warning
+		case DefaultPalette.Neutral:
+			embed.Color = palette.Neutral
+		case DefaultPalette.Info:
+			embed.Color = palette.Info
+		case DefaultPalette.Severe:
+			embed.Color = palette.Severe
+		}
+	}
+}
+
+// Compact turns the fields of a rendered message into a single line below its description,
+// for guilds that prefer short messages. Multi-line values are cut to their first line and
+// values that are still too long to be read at a glance are left out
+func Compact(messageSend *discordgo.MessageSend) {
+	for _, embed := range messageSend.Embeds {
+		var parts []string
+
+		for _, field := range embed.Fields {
+			value, _, _ := strings.Cut(strings.TrimSpace(field.Value), "\n")
+
+			if value == "" || len(value) > 100 || strings.HasPrefix(value, "```") {
+				continue
+			}
+
+			parts = append(parts, "**"+field.Name+":** "+value)
+		}
+
+		embed.Fields = nil
+
+		if len(parts) == 0 {
+			continue
+		}
+
+		line := strings.Join(parts, " · ")
+
+		if embed.Description == "" {
+			embed.Description = line
+		} else {
+			embed.Description += "\n\n" + line
+		}
+	}
+}
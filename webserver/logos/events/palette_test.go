@@ -0,0 +1,58 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestApplyPalette(t *testing.T) {
+	messageSend := &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{Color: colorSuccess},
+			{Color: colorFailure},
+			{Color: colorSevere},
+			{Color: 0x123456},
+		},
+	}
+
+	palette := DefaultPalette
+	palette.Success = 0x00aa00
+	palette.Severe = 0
+
+	ApplyPalette(messageSend, palette)
+
+	for i, want := range []int{0x00aa00, colorFailure, 0, 0x123456} {
+		if got := messageSend.Embeds[i].Color; got != want {
+			t.Errorf("embed %d: got %#x, want %#x", i, got, want)
+		}
+	}
+}
+
+func TestCompact(t *testing.T) {
+	messageSend := &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Description: "Fixes the build",
+				Fields: []*discordgo.MessageEmbedField{
+					{Name: "User", Value: "[octocat](https://github.com/octocat)"},
+					{Name: "Commits", Value: "first\nsecond"},
+					{Name: "Install", Value: "```\nnpm install\n```"},
+					{Name: "Empty", Value: ""},
+				},
+			},
+		},
+	}
+
+	Compact(messageSend)
+
+	embed := messageSend.Embeds[0]
+
+	if len(embed.Fields) != 0 {
+		t.Errorf("expected no fields, got %d", len(embed.Fields))
+	}
+
+	if want := "Fixes the build\n\n**User:** [octocat](https://github.com/octocat) · **Commits:** first"; embed.Description != want {
+		t.Errorf("got %q, want %q", embed.Description, want)
+	}
+}
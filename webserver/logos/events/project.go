@@ -0,0 +1,104 @@
+package events
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func projectFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh ProjectEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var color int
+	switch gh.Action {
+	case "created", "reopened":
+		color = colorSuccess
+	case "closed", "deleted":
+		color = colorFailure
+	default:
+		color = colorWarning
+	}
+
+	// Projects can belong to a repository or to an organization
+	var owner = gh.Repository.FullName
+	if owner == "" {
+		owner = gh.Organization.Login
+	}
+
+	var url = gh.Project.HTMLURL
+	if url == "" && gh.Repository.HTMLURL != "" {
+		url = gh.Repository.HTMLURL + "/projects"
+	} else if url == "" {
+		url = "https://github.com/orgs/" + gh.Organization.Login + "/projects"
+	}
+
+	var body = gh.Project.Body
+	if len(body) > 996 {
+		body = body[:996] + "..."
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "State",
+			Value:  gh.Project.State,
+			Inline: true,
+		},
+		{
+			Name:   "Creator",
+			Value:  gh.Project.Creator.Link(),
+			Inline: true,
+		},
+		{
+			Name:   "User",
+			Value:  gh.Sender.Link(),
+			Inline: true,
+		},
+	}
+
+	changes := gh.Changes.Name.Line(p, "Name", gh.Project.Name) + gh.Changes.Body.Line(p, "Body", gh.Project.Body)
+
+	if len(changes) > 1000 {
+		changes = changes[:1000] + "..."
+	}
+
+	if changes != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Changes",
+			Value: changes,
+		})
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:       color,
+				URL:         url,
+				Author:      gh.Sender.AuthorEmbed(),
+				Title:       p.Sprintf("Project %s %s on %s", gh.Project.Name, translateAction(p, gh.Action), owner),
+				Description: body,
+				Fields:      fields,
+			},
+		},
+	}, nil
+}
+
+func projectSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh ProjectEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s project %s", gh.Sender.Login, translateAction(p, gh.Action), gh.Project.Name)
+
+	return summaryLine(RepoWrapper{Repo: gh.Repository, Org: gh.Organization}.RouteName(), text, gh.Project.HTMLURL), nil
+}
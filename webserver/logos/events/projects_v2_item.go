@@ -0,0 +1,103 @@
+package events
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+// contentType returns the kind of item that changed, as it is shown in titles
+func (gh ProjectsV2ItemEvent) contentType() string {
+	switch gh.ProjectsV2Item.ContentType {
+	case "DraftIssue":
+		return "Draft issue"
+	case "PullRequest":
+		return "Pull request"
+	case "":
+		return "Item"
+	default:
+		return gh.ProjectsV2Item.ContentType
+	}
+}
+
+func projectsV2ItemFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh ProjectsV2ItemEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var color int
+	switch gh.Action {
+	case "created", "restored":
+		color = colorSuccess
+	case "deleted", "archived":
+		color = colorFailure
+	default:
+		color = colorWarning
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Item",
+			Value:  "``" + gh.ProjectsV2Item.NodeID + "``",
+			Inline: true,
+		},
+		{
+			Name:   "Project",
+			Value:  "``" + gh.ProjectsV2Item.ProjectNodeID + "``",
+			Inline: true,
+		},
+		{
+			Name:   "User",
+			Value:  gh.Sender.Link(),
+			Inline: true,
+		},
+	}
+
+	// The payload only identifies the changed field, its value has to be looked up through the API
+	if gh.Changes.FieldValue.FieldNodeID != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Changes",
+			Value: label(p, "Field changed") + " " + strings.ReplaceAll(gh.Changes.FieldValue.FieldType, "_", " ") + " ``" + gh.Changes.FieldValue.FieldNodeID + "``",
+		})
+	}
+
+	if changes := gh.Changes.ContentType.Line(p, "Type", gh.ProjectsV2Item.ContentType); changes != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Changes",
+			Value: changes,
+		})
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  color,
+				URL:    "https://github.com/orgs/" + gh.Organization.Login + "/projects",
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  p.Sprintf("%s %s in a project of %s", translate(p, gh.contentType()), translateAction(p, gh.Action), gh.Organization.Login),
+				Fields: fields,
+			},
+		},
+	}, nil
+}
+
+func projectsV2ItemSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh ProjectsV2ItemEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s %s in a project", gh.Sender.Login, translateAction(p, gh.Action), translate(p, gh.contentType()))
+
+	return summaryLine(gh.Organization.Login, text, "https://github.com/orgs/"+gh.Organization.Login+"/projects"), nil
+}
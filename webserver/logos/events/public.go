@@ -1,17 +1,11 @@
 package events
 
 import (
-	"fmt"
-
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type PublicEvent struct {
-	Repo   Repository `json:"repository"`
-	Sender User       `json:"sender"`
-}
-
-func publicFn(bytes []byte) (*discordgo.MessageSend, error) {
+func publicFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh PublicEvent
 
 	// Unmarshal the JSON into our struct
@@ -24,10 +18,10 @@ func publicFn(bytes []byte) (*discordgo.MessageSend, error) {
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:  colorGreen,
-				URL:    gh.Repo.HTMLURL,
+				Color:  colorInfo,
+				URL:    gh.Repository.HTMLURL,
 				Author: gh.Sender.AuthorEmbed(),
-				Title:  fmt.Sprintf("Repository update: %s", gh.Repo.FullName),
+				Title:  p.Sprintf("Repository update: %s", gh.Repository.FullName),
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:  "User",
@@ -42,3 +36,16 @@ func publicFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func publicSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh PublicEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, p.Sprintf("%s made the repository public", gh.Sender.Login), gh.Repository.HTMLURL), nil
+}
@@ -1,19 +1,13 @@
 package events
 
 import (
-	"fmt"
+	"strconv"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type PullRequestEvent struct {
-	Action      string      `json:"action"`
-	Repo        Repository  `json:"repository"`
-	Sender      User        `json:"sender"`
-	PullRequest PullRequest `json:"pull_request"`
-}
-
-func pullRequestFn(bytes []byte) (*discordgo.MessageSend, error) {
+func pullRequestFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh PullRequestEvent
 
 	// Unmarshal the JSON into our struct
@@ -23,20 +17,24 @@ func pullRequestFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
-	var body string = gh.PullRequest.Body
-	if len(gh.PullRequest.Body) > 1000 {
-		body = gh.PullRequest.Body[:1000]
-	}
+	var body = formatBody(p, gh.PullRequest.Body, 1000, gh.PullRequest.HTMLURL)
 
 	if body == "" {
-		body = "No description available"
+		body = p.Sprintf("No description available")
 	}
 
 	var color int
-	if gh.Action == "closed" {
-		color = colorRed
-	} else {
-		color = colorGreen
+	switch gh.Action {
+	case "opened", "reopened", "ready_for_review":
+		color = colorSuccess
+	case "closed":
+		if gh.PullRequest.Merged {
+			color = colorSuccess
+		} else {
+			color = colorFailure
+		}
+	default:
+		color = colorInfo
 	}
 
 	return &discordgo.MessageSend{
@@ -45,7 +43,7 @@ func pullRequestFn(bytes []byte) (*discordgo.MessageSend, error) {
 				Color:  color,
 				URL:    gh.PullRequest.HTMLURL,
 				Author: gh.Sender.AuthorEmbed(),
-				Title:  fmt.Sprintf("Pull Request %s on %s (#%d)", gh.Action, gh.Repo.FullName, gh.PullRequest.Number),
+				Title:  p.Sprintf("Pull Request %s on %s (#%s)", translateAction(p, gh.Action), gh.Repository.FullName, strconv.Itoa(gh.PullRequest.Number)),
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:  "Action",
@@ -65,10 +63,30 @@ func pullRequestFn(bytes []byte) (*discordgo.MessageSend, error) {
 					},
 					{
 						Name:  "More Information",
-						Value: fmt.Sprintf("**Base Ref:** %s\n**Base Label:** %s\n**Head Ref:** %s\n**Head Label:** %s", gh.PullRequest.Base.Ref, gh.PullRequest.Base.Label, gh.PullRequest.Head.Ref, gh.PullRequest.Head.Label),
+						Value: label(p, "Base Ref") + " " + gh.PullRequest.Base.Ref + "\n" + label(p, "Base Label") + " " + gh.PullRequest.Base.Label + "\n" + label(p, "Head Ref") + " " + gh.PullRequest.Head.Ref + "\n" + label(p, "Head Label") + " " + gh.PullRequest.Head.Label,
 					},
 				},
 			},
 		},
 	}, nil
 }
+
+func pullRequestSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh PullRequestEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var action = gh.Action
+	if action == "closed" && gh.PullRequest.Merged {
+		action = "merged"
+	}
+
+	var text = p.Sprintf("%s %s pull request #%s: %s", gh.Sender.Login, translateAction(p, action), strconv.Itoa(gh.PullRequest.Number), gh.PullRequest.Title)
+
+	return summaryLine(gh.Repository.FullName, text, gh.PullRequest.HTMLURL), nil
+}
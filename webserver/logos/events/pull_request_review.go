@@ -0,0 +1,133 @@
+package events
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func pullRequestReviewFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh PullRequestReviewEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var emoji string
+	var state string
+	var color int
+
+	switch gh.Review.State {
+	case "approved":
+		emoji = "✅"
+		state = "Approved"
+		color = colorSuccess
+	case "changes_requested":
+		emoji = "❌"
+		state = "Changes Requested"
+		color = colorFailure
+	case "commented":
+		emoji = "💬"
+		state = "Commented"
+		color = colorWarning
+	case "dismissed":
+		emoji = "🚫"
+		state = "Dismissed"
+		color = colorNeutral
+	default:
+		emoji = "ℹ️"
+		state = gh.Review.State
+		color = colorWarning
+	}
+
+	var title string
+	switch gh.Action {
+	case "dismissed":
+		title = p.Sprintf("%s Review dismissed on %s (#%s)", emoji, gh.Repository.FullName, strconv.Itoa(gh.PullRequest.Number))
+	case "edited":
+		title = p.Sprintf("%s Review edited on %s (#%s)", emoji, gh.Repository.FullName, strconv.Itoa(gh.PullRequest.Number))
+	default:
+		title = p.Sprintf("%s Review %s on %s (#%s)", emoji, translate(p, state), gh.Repository.FullName, strconv.Itoa(gh.PullRequest.Number))
+	}
+
+	var body = formatBody(p, gh.Review.Body, 2000, gh.Review.HTMLURL)
+
+	if body == "" {
+		body = p.Sprintf("No review body")
+	}
+
+	var pullRequest = fmt.Sprintf("[#%d %s](%s)", gh.PullRequest.Number, gh.PullRequest.Title, gh.PullRequest.HTMLURL)
+
+	if len(pullRequest) > 1000 {
+		pullRequest = fmt.Sprintf("[#%d](%s)", gh.PullRequest.Number, gh.PullRequest.HTMLURL)
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:       color,
+				URL:         gh.Review.HTMLURL,
+				Author:      gh.Sender.AuthorEmbed(),
+				Title:       title,
+				Description: body,
+				Fields: []*discordgo.MessageEmbedField{
+					{
+						Name:   "Reviewer",
+						Value:  gh.Review.User.Link(),
+						Inline: true,
+					},
+					{
+						Name:   "State",
+						Value:  translate(p, state),
+						Inline: true,
+					},
+					{
+						Name:  "Pull Request",
+						Value: pullRequest,
+					},
+					{
+						Name:  "Refs",
+						Value: label(p, "Head") + " " + gh.PullRequest.Head.Ref + "\n" + label(p, "Base") + " " + gh.PullRequest.Base.Ref,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func pullRequestReviewSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh PullRequestReviewEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var number = strconv.Itoa(gh.PullRequest.Number)
+
+	var text string
+	switch {
+	case gh.Action != "submitted":
+		text = p.Sprintf("%s %s a review on #%s: %s", gh.Sender.Login, translateAction(p, gh.Action), number, gh.PullRequest.Title)
+	case gh.Review.State == "approved":
+		text = p.Sprintf("%s approved #%s: %s", gh.Sender.Login, number, gh.PullRequest.Title)
+	case gh.Review.State == "changes_requested":
+		text = p.Sprintf("%s requested changes on #%s: %s", gh.Sender.Login, number, gh.PullRequest.Title)
+	default:
+		text = p.Sprintf("%s reviewed #%s: %s", gh.Sender.Login, number, gh.PullRequest.Title)
+	}
+
+	var url = gh.Review.HTMLURL
+	if url == "" {
+		url = gh.PullRequest.HTMLURL
+	}
+
+	return summaryLine(gh.Repository.FullName, text, url), nil
+}
@@ -4,21 +4,10 @@ import (
 	"strconv"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type PullRequestReviewCommentEvent struct {
-	Action      string      `json:"action"`
-	Repo        Repository  `json:"repository"`
-	Sender      User        `json:"sender"`
-	PullRequest PullRequest `json:"pull_request"`
-	Comment     struct {
-		Body    string `json:"body"`
-		HTMLURL string `json:"html_url"`
-		User    User   `json:"user"`
-	} `json:"comment"`
-}
-
-func pullRequestReviewCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
+func pullRequestReviewCommentFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh PullRequestReviewCommentEvent
 
 	// Unmarshal the JSON into our struct
@@ -28,30 +17,26 @@ func pullRequestReviewCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
-	var body string = gh.PullRequest.Body
-	if len(gh.PullRequest.Body) > 1000 {
-		body = gh.PullRequest.Body[:1000]
-	}
+	var body = formatBody(p, gh.PullRequest.Body, 1000, gh.PullRequest.HTMLURL)
 
 	if body == "" {
-		body = "No description available"
+		body = p.Sprintf("No description available")
 	}
 
-	var comment string = gh.Comment.Body
-
-	if len(gh.Comment.Body) > 1000 {
-		comment = gh.Comment.Body[:1000] + "..."
-	}
+	var comment = formatBody(p, gh.Comment.Body, 1000, gh.Comment.HTMLURL)
 
 	if comment == "" {
-		comment = "No description available"
+		comment = p.Sprintf("No description available")
 	}
 
 	var color int
-	if gh.Action == "deleted" {
-		color = colorRed
-	} else {
-		color = colorGreen
+	switch gh.Action {
+	case "deleted":
+		color = colorFailure
+	case "edited":
+		color = colorWarning
+	default:
+		color = colorInfo
 	}
 
 	return &discordgo.MessageSend{
@@ -61,7 +46,7 @@ func pullRequestReviewCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 				URL:         gh.PullRequest.HTMLURL,
 				Author:      gh.Sender.AuthorEmbed(),
 				Description: comment,
-				Title:       "Pull Request Review Comment on " + gh.Repo.FullName + " (#" + strconv.Itoa(gh.PullRequest.Number) + ")",
+				Title:       p.Sprintf("Pull Request Review Comment on %s (#%s)", gh.Repository.FullName, strconv.Itoa(gh.PullRequest.Number)),
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:  "User",
@@ -80,3 +65,16 @@ func pullRequestReviewCommentFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func pullRequestReviewCommentSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh PullRequestReviewCommentEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, commentSummary(p, gh.Action, gh.Sender.Login, "#"+strconv.Itoa(gh.PullRequest.Number), gh.Comment.Body), gh.Comment.HTMLURL), nil
+}
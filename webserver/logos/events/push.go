@@ -2,34 +2,46 @@ package events
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type PushEvent struct {
-	Commits []struct { // push
-		ID        string `json:"id"`
-		Message   string `json:"message"`
-		Timestamp string `json:"timestamp"`
-		URL       string `json:"url"`
-		Author    struct {
-			Name     string `json:"name"`
-			Email    string `json:"email"`
-			Username string `json:"username"`
-		} `json:"author"`
-	} `json:"commits"`
-	Repo   Repository `json:"repository"`
-	Sender User       `json:"sender"`
-	Pusher struct {   // push
-		Name        string `json:"name"`
-		Description string `json:"description"`
-	} `json:"pusher,omitempty"`
-	Ref     string `json:"ref"`
-	BaseRef string `json:"base_ref"`
+// GitHub only includes up to this many commits in a push event
+const pushCommitLimit = 20
+
+// shortRef strips the refs/heads/ or refs/tags/ prefix from a ref
+func shortRef(ref string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(ref, "refs/heads/"), "refs/tags/")
+}
+
+// isZeroSHA returns whether a SHA is missing or all zeros, which GitHub uses for the
+// before of a created ref and the after of a deleted one
+func isZeroSHA(sha string) bool {
+	return len(sha) < 7 || strings.Trim(sha, "0") == ""
 }
 
-func pushFn(bytes []byte) (*discordgo.MessageSend, error) {
+// commitLine formats a single commit of a push as one line of the commit list
+func (c PushCommit) commitLine() string {
+	// If the username is empty, use the name instead
+	username := c.Author.Username
+	if username == "" {
+		username = c.Author.Name
+	}
+
+	// Only the first line of the message is shown
+	message, _, _ := strings.Cut(c.Message, "\n")
+
+	if len(message) > 100 {
+		message = cutAtBoundary(message, 100) + "..."
+	}
+
+	return fmt.Sprintf("%s [``%s``](%s) | [%s](%s)\n", message, shortSHA(c.ID), c.URL, username, strings.ReplaceAll("https://github.com/"+username, " ", "%20"))
+}
+
+func pushFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh PushEvent
 
 	// Unmarshal the JSON into our struct
@@ -39,64 +51,197 @@ func pushFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
-	var commitList string
-	for _, commit := range gh.Commits {
-		fmt.Println(commit.Author)
+	var refType = "Branch"
+	if strings.HasPrefix(gh.Ref, "refs/tags/") {
+		refType = "Tag"
+	}
 
-		// If the username is empty, use the name instead
-		if commit.Author.Username == "" {
-			commit.Author.Username = commit.Author.Name
-		}
+	var ref = shortRef(gh.Ref)
 
-		if len(commit.Message) > 100 {
-			commit.Message = commit.Message[:100] + "..."
-		}
+	var color int
+	var title string
+	var url = gh.Compare
+	switch {
+	case gh.Deleted:
+		color = colorFailure
+		title = p.Sprintf("%s %s deleted on %s", translate(p, refType), ref, gh.Repository.FullName)
+		url = gh.Repository.HTMLURL
+	case gh.Created:
+		color = colorSuccess
+		title = p.Sprintf("%s %s created on %s", translate(p, refType), ref, gh.Repository.FullName)
+		url = gh.Repository.HTMLURL + "/tree/" + ref
+	case refType == "Tag":
+		color = colorWarning
+		title = p.Sprintf("Tag %s moved on %s", ref, gh.Repository.FullName)
+	case gh.Forced:
+		color = colorWarning
+		title = p.Sprintf("Force push to %s on %s", ref, gh.Repository.FullName)
+	default:
+		color = colorSuccess
+		title = p.Sprintf("Push to %s on %s", ref, gh.Repository.FullName)
+	}
 
-		commitList += fmt.Sprintf("%s [``%s``](%s) | [%s](%s)\n", commit.Message, commit.ID[:7], commit.URL, commit.Author.Username, strings.ReplaceAll("https://github.com/"+commit.Author.Username, " ", "%20"))
+	if url == "" {
+		url = gh.Repository.HTMLURL
 	}
 
-	if len(commitList) > 1024 {
-		commitList = commitList[:1024] + "..."
+	branchInfo := label(p, "Ref") + " " + ref
+
+	if gh.BaseRef != "" {
+		branchInfo += "\n" + label(p, "Base Ref") + " " + shortRef(gh.BaseRef)
 	}
 
-	if commitList == "" {
-		commitList = "No commits?"
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:  refType,
+			Value: branchInfo,
+		},
+	}
+
+	// Deleted refs no longer point anywhere, so show where they pointed before
+	if gh.Deleted && !isZeroSHA(gh.Before) {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Last Commit",
+			Value: gh.Repository.Commit(gh.Before),
+		})
 	}
 
-	branchInfo := "**Ref:** " + gh.Ref
+	// Force pushes rewrite history, so show both ends of the rewrite
+	if gh.Forced && !gh.Deleted && !gh.Created {
+		var shas = label(p, "Before") + " " + gh.Repository.Commit(gh.Before) + "\n" + label(p, "After") + " " + gh.Repository.Commit(gh.After)
 
-	if gh.BaseRef != "" {
-		branchInfo = "\n" + "**Base Ref:** " + gh.BaseRef
+		if gh.Compare != "" {
+			shas += "\n[" + p.Sprintf("Compare changes") + "](" + gh.Compare + ")"
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Force Push",
+			Value: shas,
+		})
 	}
 
+	// A new tag or branch that points at an existing commit has no commits of its own
+	if !gh.Deleted && len(gh.Commits) == 0 && gh.HeadCommit != nil {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Head Commit",
+			Value: gh.HeadCommit.commitLine(),
+		})
+	} else if !gh.Deleted {
+		var commitList string
+		for i, commit := range gh.Commits {
+			line := commit.commitLine()
+
+			// Leave some room for the link to the full list
+			if len(commitList)+len(line) > 900 {
+				commitList += p.Sprintf("...and %d more", len(gh.Commits)-i)
+				break
+			}
+
+			commitList += line
+		}
+
+		if commitList == "" {
+			commitList = p.Sprintf("No commits?")
+		}
+
+		var distinct int
+		for _, commit := range gh.Commits {
+			if commit.Distinct {
+				distinct++
+			}
+		}
+
+		var count = fmt.Sprintf("%d", len(gh.Commits))
+
+		if len(gh.Commits) >= pushCommitLimit {
+			count += "+"
+			commitList += "\n[" + p.Sprintf("View all commits") + "](" + gh.Compare + ")"
+		}
+
+		if distinct != len(gh.Commits) {
+			count += p.Sprintf(", %d distinct", distinct)
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  p.Sprintf("Commits (%s)", count),
+			Value: commitList,
+		})
+	}
+
+	fields = append(fields, []*discordgo.MessageEmbedField{
+		{
+			Name:   "Commit Sender",
+			Value:  gh.Sender.Link(),
+			Inline: true,
+		},
+		{
+			Name:   "Pusher",
+			Value:  fmt.Sprintf("[%s](%s)", gh.Pusher.Name, "https://github.com/"+gh.Pusher.Name),
+			Inline: true,
+		},
+	}...)
+
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:  colorGreen,
-				URL:    gh.Repo.HTMLURL,
+				Color:  color,
+				URL:    url,
 				Author: gh.Sender.AuthorEmbed(),
-				Title:  "Push on " + gh.Repo.FullName,
-				Fields: []*discordgo.MessageEmbedField{
-					{
-						Name:  "Branch",
-						Value: branchInfo,
-					},
-					{
-						Name:  "Commits",
-						Value: commitList,
-					},
-					{
-						Name:   "Commit Sender",
-						Value:  gh.Sender.Link(),
-						Inline: true,
-					},
-					{
-						Name:   "Pusher",
-						Value:  fmt.Sprintf("[%s](%s)", gh.Pusher.Name, "https://github.com/"+gh.Pusher.Name),
-						Inline: true,
-					},
-				},
+				Title:  title,
+				Fields: fields,
 			},
 		},
 	}, nil
 }
+
+func pushSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh PushEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var ref = shortRef(gh.Ref)
+
+	switch {
+	case gh.Deleted:
+		return summaryLine(gh.Repository.FullName, p.Sprintf("%s deleted %s", gh.Sender.Login, ref), gh.Repository.HTMLURL), nil
+	case gh.Created && len(gh.Commits) == 0:
+		return summaryLine(gh.Repository.FullName, p.Sprintf("%s created %s", gh.Sender.Login, ref), gh.Repository.HTMLURL+"/tree/"+ref), nil
+	case len(gh.Commits) == 0:
+		return summaryLine(gh.Repository.FullName, p.Sprintf("%s updated %s", gh.Sender.Login, ref), gh.Repository.HTMLURL+"/tree/"+ref), nil
+	}
+
+	// Truncated pushes have more commits than the payload lists
+	var count = strconv.Itoa(len(gh.Commits))
+	if len(gh.Commits) >= pushCommitLimit {
+		count += "+"
+	}
+
+	var commits = p.Sprintf("1 commit")
+	if len(gh.Commits) != 1 {
+		commits = p.Sprintf("%s commits", count)
+	}
+
+	var messages []string
+	for _, commit := range gh.Commits {
+		messages = append(messages, snippet(commit.Message, 50))
+	}
+
+	var text string
+	if gh.Forced {
+		text = p.Sprintf("%s force-pushed %s to %s: %s", gh.Sender.Login, commits, ref, snippet(strings.Join(messages, ", "), 150))
+	} else {
+		text = p.Sprintf("%s pushed %s to %s: %s", gh.Sender.Login, commits, ref, snippet(strings.Join(messages, ", "), 150))
+	}
+
+	var url = gh.Compare
+	if url == "" {
+		url = gh.Repository.HTMLURL
+	}
+
+	return summaryLine(gh.Repository.FullName, text, url), nil
+}
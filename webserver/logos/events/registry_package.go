@@ -0,0 +1,32 @@
+package events
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func registryPackageFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh RegistryPackageEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	return packageMessage(p, gh.Action, gh.Repository, gh.Sender, gh.RegistryPackage), nil
+}
+
+func registryPackageSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh RegistryPackageEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, packageLine(p, gh.Action, gh.Sender, gh.RegistryPackage), gh.RegistryPackage.HTMLURL), nil
+}
@@ -4,20 +4,10 @@ import (
 	"github.com/bwmarrin/discordgo"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 )
 
-type ReleaseEvent struct {
-	Action  string     `json:"action"`
-	Repo    Repository `json:"repository"`
-	Sender  User       `json:"sender"`
-	Release struct {
-		HTMLUrl string `json:"html_url"`
-		Body    string `json:"body"`
-		TagName string `json:"tag_name"`
-	} `json:"release"`
-}
-
-func releaseFn(bytes []byte) (*discordgo.MessageSend, error) {
+func releaseFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh ReleaseEvent
 
 	// Unmarshal the JSON into our struct
@@ -28,11 +18,14 @@ func releaseFn(bytes []byte) (*discordgo.MessageSend, error) {
 	}
 
 	var color int
-	var title string = cases.Title(language.English).String(gh.Action) + " release on " + gh.Repo.FullName
-	if gh.Action == "created" || gh.Action == "published" || gh.Action == "edited" || gh.Action == "prereleased" || gh.Action == "released" {
-		color = colorGreen
-	} else {
-		color = colorRed
+	var title string = cases.Title(language.English).String(translateAction(p, gh.Action)) + p.Sprintf(" release on %s", gh.Repository.FullName)
+	switch gh.Action {
+	case "created", "published", "prereleased", "released":
+		color = colorSuccess
+	case "edited":
+		color = colorWarning
+	default:
+		color = colorFailure
 	}
 
 	var body string = gh.Release.Body
@@ -41,14 +34,14 @@ func releaseFn(bytes []byte) (*discordgo.MessageSend, error) {
 	}
 
 	if body == "" {
-		body = "No description available"
+		body = p.Sprintf("No description available")
 	}
 
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
 				Color:       color,
-				URL:         gh.Repo.HTMLURL,
+				URL:         gh.Repository.HTMLURL,
 				Title:       title,
 				Author:      gh.Sender.AuthorEmbed(),
 				Description: body,
@@ -60,7 +53,7 @@ func releaseFn(bytes []byte) (*discordgo.MessageSend, error) {
 					},
 					{
 						Name:   "Release",
-						Value:  "[" + gh.Release.TagName + "]" + "(" + gh.Release.HTMLUrl + ")",
+						Value:  "[" + gh.Release.TagName + "]" + "(" + gh.Release.HTMLURL + ")",
 						Inline: true,
 					},
 				},
@@ -68,3 +61,18 @@ func releaseFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func releaseSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh ReleaseEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s release %s", gh.Sender.Login, translateAction(p, gh.Action), gh.Release.TagName)
+
+	return summaryLine(gh.Repository.FullName, text, gh.Release.HTMLURL), nil
+}
@@ -4,15 +4,10 @@ import (
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type RepositoryEvent struct {
-	Action string     `json:"action"`
-	Repo   Repository `json:"repository"`
-	Sender User       `json:"sender"`
-}
-
-func repositoryFn(bytes []byte) (*discordgo.MessageSend, error) {
+func repositoryFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh RepositoryEvent
 
 	// Unmarshal the JSON into our struct
@@ -24,28 +19,69 @@ func repositoryFn(bytes []byte) (*discordgo.MessageSend, error) {
 
 	var color int
 	var title string
-	if gh.Action == "created" {
-		color = colorGreen
-		title = "Created: " + gh.Repo.FullName
-	} else {
-		color = colorRed
-		title = strings.ToUpper(gh.Action) + ": " + gh.Repo.FullName
+	switch gh.Action {
+	case "created":
+		color = colorSuccess
+		title = p.Sprintf("Created: %s", gh.Repository.FullName)
+	case "deleted":
+		color = colorFailure
+		title = strings.ToUpper(translateAction(p, gh.Action)) + ": " + gh.Repository.FullName
+	case "archived", "privatized":
+		color = colorNeutral
+		title = strings.ToUpper(translateAction(p, gh.Action)) + ": " + gh.Repository.FullName
+	default:
+		color = colorWarning
+		title = strings.ToUpper(translateAction(p, gh.Action)) + ": " + gh.Repository.FullName
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:  "User",
+			Value: gh.Sender.Link(),
+		},
+	}
+
+	changes := gh.Changes.Description.Line(p, "Description", gh.Repository.Description) +
+		gh.Changes.Homepage.Line(p, "Homepage", gh.Repository.Homepage) +
+		gh.Changes.DefaultBranch.Line(p, "Default Branch", gh.Repository.DefaultBranch)
+
+	// Topics are sent as the whole list before the edit
+	if gh.Changes.Topics.From != nil {
+		changes += (&Change{
+			From: strings.Join(gh.Changes.Topics.From, ", "),
+			To:   strings.Join(gh.Repository.Topics, ", "),
+		}).Line(p, "Topics", "")
+	}
+
+	if changes != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Changes",
+			Value: changes,
+		})
 	}
 
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
 				Color:  color,
-				URL:    gh.Repo.HTMLURL,
+				URL:    gh.Repository.HTMLURL,
 				Title:  title,
 				Author: gh.Sender.AuthorEmbed(),
-				Fields: []*discordgo.MessageEmbedField{
-					{
-						Name:  "User",
-						Value: gh.Sender.Link(),
-					},
-				},
+				Fields: fields,
 			},
 		},
 	}, nil
 }
+
+func repositorySummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh RepositoryEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, p.Sprintf("%s %s the repository", gh.Sender.Login, translateAction(p, gh.Action)), gh.Repository.HTMLURL), nil
+}
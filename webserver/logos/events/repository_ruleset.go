@@ -0,0 +1,229 @@
+package events
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+// User friendly names for ruleset rule types, other types are shown as is
+var rulesetRuleNames = map[string]string{
+	"creation":                    "Restrict creations",
+	"update":                      "Restrict updates",
+	"deletion":                    "Restrict deletions",
+	"non_fast_forward":            "Block force pushes",
+	"required_linear_history":     "Require linear history",
+	"required_signatures":         "Require signed commits",
+	"required_deployments":        "Require deployments",
+	"pull_request":                "Require a pull request",
+	"required_status_checks":      "Require status checks",
+	"merge_queue":                 "Require merge queue",
+	"code_scanning":               "Require code scanning results",
+	"commit_message_pattern":      "Commit message pattern",
+	"commit_author_email_pattern": "Commit author email pattern",
+	"committer_email_pattern":     "Committer email pattern",
+	"branch_name_pattern":         "Branch name pattern",
+	"tag_name_pattern":            "Tag name pattern",
+}
+
+// name returns the user friendly name of the rule
+func (r rulesetRule) name(p *message.Printer) string {
+	if name, ok := rulesetRuleNames[r.Type]; ok {
+		return p.Sprintf(name)
+	}
+
+	return strings.ReplaceAll(r.Type, "_", " ")
+}
+
+// parameters formats the parameters of the rule as a sorted, comma separated list
+func (r rulesetRule) parameters() string {
+	var keys []string
+	for k := range r.Parameters {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var params []string
+	for _, k := range keys {
+		var value string
+
+		switch v := r.Parameters[k].(type) {
+		case string, bool, float64:
+			value = fmt.Sprint(v)
+		default:
+			b, _ := json.Marshal(v)
+			value = string(b)
+		}
+
+		params = append(params, k+"="+value)
+	}
+
+	return strings.Join(params, ", ")
+}
+
+func (r repositoryRuleset) settings(p *message.Printer) string {
+	var targets = strings.Join(r.Conditions.RefName.Include, ", ")
+
+	if len(r.Conditions.RefName.Exclude) > 0 {
+		targets += " " + p.Sprintf("(excluding %s)", strings.Join(r.Conditions.RefName.Exclude, ", "))
+	}
+
+	settings := []KeyValue{
+		{
+			Key:   "Enforcement",
+			Value: r.Enforcement,
+		},
+		{
+			Key:   "Target",
+			Value: r.Target,
+		},
+		{
+			Key:   "Applies to",
+			Value: targets,
+		},
+		{
+			Key:   "Bypass actors",
+			Value: len(r.BypassActors),
+		},
+	}
+
+	for _, rule := range r.Rules {
+		settings = append(settings, KeyValue{
+			Key:   rule.name(p),
+			Value: rule.parameters(),
+		})
+	}
+
+	settingsStr := ""
+
+	for _, setting := range settings {
+		if len(settingsStr) > 2500 {
+			settingsStr += "\n..."
+			break
+		}
+
+		setting.Key = translate(p, setting.Key)
+
+		if setting.Value == "" {
+			settingsStr += "**" + setting.Key + "**\n"
+			continue
+		}
+
+		settingsStr += setting.StringMD() + "\n"
+	}
+
+	return settingsStr
+}
+
+// diff summarizes the changes made to the ruleset
+func (gh RepositoryRulesetEvent) diff(p *message.Printer) string {
+	diff := gh.Changes.Name.Line(p, "Name", gh.RepositoryRuleset.Name) +
+		gh.Changes.Enforcement.Line(p, "Enforcement", gh.RepositoryRuleset.Enforcement)
+
+	ruleNames := func(rules []rulesetRule) string {
+		var names []string
+		for _, rule := range rules {
+			names = append(names, rule.name(p))
+		}
+
+		return strings.Join(names, ", ")
+	}
+
+	if len(gh.Changes.Rules.Added) > 0 {
+		diff += label(p, "Rules added") + " " + ruleNames(gh.Changes.Rules.Added) + "\n"
+	}
+
+	if len(gh.Changes.Rules.Deleted) > 0 {
+		diff += label(p, "Rules removed") + " " + ruleNames(gh.Changes.Rules.Deleted) + "\n"
+	}
+
+	if len(gh.Changes.Rules.Updated) > 0 {
+		var updated []rulesetRule
+		for _, u := range gh.Changes.Rules.Updated {
+			updated = append(updated, u.Rule)
+		}
+
+		diff += label(p, "Rules updated") + " " + ruleNames(updated) + "\n"
+	}
+
+	if conditions := len(gh.Changes.Conditions.Added) + len(gh.Changes.Conditions.Deleted) + len(gh.Changes.Conditions.Updated); conditions > 0 {
+		diff += label(p, "Conditions changed") + " " + strconv.Itoa(conditions) + "\n"
+	}
+
+	return diff
+}
+
+func repositoryRulesetFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh RepositoryRulesetEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var color int
+	switch gh.Action {
+	case "created":
+		color = colorSuccess
+	case "edited":
+		color = colorWarning
+	default:
+		color = colorFailure
+	}
+
+	desc := label(p, "Settings") + "\n\n" + gh.RepositoryRuleset.settings(p)
+
+	if diff := gh.diff(p); diff != "" {
+		desc += "\n" + label(p, "Changes") + "\n\n" + diff
+	}
+
+	var url = gh.RepositoryRuleset.Links.HTML.Href
+	if url == "" || gh.Action == "deleted" {
+		url = gh.Repository.HTMLURL + "/settings/rules"
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:       color,
+				URL:         url,
+				Title:       p.Sprintf("Ruleset %s %s on %s", gh.RepositoryRuleset.Name, translateAction(p, gh.Action), gh.Repository.FullName),
+				Author:      gh.Sender.AuthorEmbed(),
+				Description: desc,
+				Fields: []*discordgo.MessageEmbedField{
+					{
+						Name:  "User",
+						Value: gh.Sender.Link(),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func repositoryRulesetSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh RepositoryRulesetEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s ruleset %s", gh.Sender.Login, translateAction(p, gh.Action), gh.RepositoryRuleset.Name)
+
+	var url = gh.RepositoryRuleset.Links.HTML.Href
+	if url == "" || gh.Action == "deleted" {
+		url = gh.Repository.HTMLURL + "/settings/rules"
+	}
+
+	return summaryLine(gh.Repository.FullName, text, url), nil
+}
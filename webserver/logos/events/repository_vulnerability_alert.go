@@ -0,0 +1,141 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+// action returns the past tense of the action, which this event sends in the present tense
+func (gh RepositoryVulnerabilityAlertEvent) action() string {
+	switch gh.Action {
+	case "create", "reopen", "dismiss", "resolve":
+		return strings.TrimSuffix(gh.Action, "e") + "ed"
+	default:
+		return gh.Action
+	}
+}
+
+func repositoryVulnerabilityAlertFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh RepositoryVulnerabilityAlertEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var color int
+	switch gh.Action {
+	case "create", "reopen":
+		color = severityColor(gh.Alert.Severity)
+	case "dismiss":
+		color = colorNeutral
+	case "resolve":
+		color = colorSuccess
+	default:
+		color = colorWarning
+	}
+
+	// Older payloads do not include the alert number, so link to the list of alerts instead
+	var url = gh.Repository.HTMLURL + "/security/dependabot"
+	if gh.Alert.Number != 0 {
+		url += fmt.Sprintf("/%d", gh.Alert.Number)
+	}
+
+	var pkg = gh.Alert.AffectedPackageName
+	if gh.Alert.AffectedRange != "" {
+		pkg += " (" + gh.Alert.AffectedRange + ")"
+	}
+
+	var details = label(p, "Package") + " " + pkg
+
+	if gh.Alert.Severity != "" {
+		details += "\n" + label(p, "Severity") + " " + gh.Alert.Severity
+	}
+
+	if gh.Alert.FixedIn != "" {
+		details += "\n" + label(p, "Fixed In") + " " + gh.Alert.FixedIn
+	} else {
+		details += "\n" + label(p, "Fixed In") + " " + p.Sprintf("No patched version available")
+	}
+
+	if gh.Alert.GHSAID != "" {
+		details += "\n" + label(p, "GHSA ID") + " [" + gh.Alert.GHSAID + "](https://github.com/advisories/" + gh.Alert.GHSAID + ")"
+	}
+
+	if gh.Alert.ExternalIdentifier != "" {
+		if gh.Alert.ExternalReference != "" {
+			details += "\n" + label(p, "Advisory") + " [" + gh.Alert.ExternalIdentifier + "](" + gh.Alert.ExternalReference + ")"
+		} else {
+			details += "\n" + label(p, "Advisory") + " " + gh.Alert.ExternalIdentifier
+		}
+	}
+
+	if len(details) > 1020 {
+		details = details[:1020] + "..."
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:  "Details",
+			Value: details,
+		},
+	}
+
+	if gh.Action == "dismiss" {
+		var dismissed string
+
+		if gh.Alert.DismissReason != "" {
+			dismissed += label(p, "Reason") + " " + gh.Alert.DismissReason
+		}
+
+		if len(dismissed) > 1000 {
+			dismissed = dismissed[:1000] + "..."
+		}
+
+		if gh.Alert.Dismisser.Login != "" {
+			dismissed += "\n" + label(p, "Dismissed By") + " " + gh.Alert.Dismisser.Link()
+		}
+
+		if dismissed != "" {
+			fields = append(fields, &discordgo.MessageEmbedField{
+				Name:  "Dismissal Details",
+				Value: dismissed,
+			})
+		}
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:  color,
+				URL:    url,
+				Author: gh.Sender.AuthorEmbed(),
+				Title:  p.Sprintf("Vulnerability alert %s on %s: %s", translate(p, gh.action()), gh.Repository.FullName, gh.Alert.AffectedPackageName),
+				Fields: fields,
+			},
+		},
+	}, nil
+}
+
+func repositoryVulnerabilityAlertSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh RepositoryVulnerabilityAlertEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("Vulnerability alert %s for %s", translate(p, gh.action()), gh.Alert.AffectedPackageName)
+	if gh.Alert.Severity != "" {
+		text += " (" + translate(p, gh.Alert.Severity) + ")"
+	}
+
+	return summaryLine(gh.Repository.FullName, text, gh.Repository.HTMLURL+"/security"), nil
+}
@@ -1,8 +0,0 @@
-// WIP
-package events
-
-type RepositiryVulnerabilityAlert struct {
-	Action string     `json:"action"`
-	Repo   Repository `json:"repository"`
-	Sender User       `json:"sender"`
-}
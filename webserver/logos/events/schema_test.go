@@ -0,0 +1,344 @@
+package events
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// loadSchema reads a schema from the schema directory, by its path relative to it
+func loadSchema(t *testing.T, name string) map[string]any {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("schema", name))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schema map[string]any
+	if err := json.Unmarshal(body, &schema); err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+
+	return schema
+}
+
+// resolveSchema follows a $ref to a shared definition and the non-null alternative of a oneOf,
+// and returns the path of the shared definition if there was one
+func resolveSchema(t *testing.T, schema map[string]any) (map[string]any, string) {
+	t.Helper()
+
+	if oneOf, ok := schema["oneOf"].([]any); ok && len(oneOf) > 0 {
+		if alternative, ok := oneOf[0].(map[string]any); ok {
+			schema = alternative
+		}
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		return loadSchema(t, ref), ref
+	}
+
+	return schema, ""
+}
+
+// schemaTypes returns the JSON types of a schema other than null
+func schemaTypes(schema map[string]any) []string {
+	var types []string
+
+	switch typ := schema["type"].(type) {
+	case string:
+		types = append(types, typ)
+	case []any:
+		for _, v := range typ {
+			if v, ok := v.(string); ok {
+				types = append(types, v)
+			}
+		}
+	}
+
+	for i := 0; i < len(types); i++ {
+		if types[i] == "null" {
+			types = append(types[:i], types[i+1:]...)
+			i--
+		}
+	}
+
+	return types
+}
+
+// acceptsType returns whether a Go type can be unmarshalled from a JSON value of the given type
+func acceptsType(typ reflect.Type, jsonType string) bool {
+	switch typ.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.String:
+		return jsonType == "string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return jsonType == "integer"
+	case reflect.Float32, reflect.Float64:
+		return jsonType == "number" || jsonType == "integer"
+	case reflect.Bool:
+		return jsonType == "boolean"
+	case reflect.Slice, reflect.Array:
+		return jsonType == "array"
+	case reflect.Struct, reflect.Map:
+		return jsonType == "object"
+	}
+
+	return false
+}
+
+// ignoredSchemaFields are the schema properties payload types leave out on purpose, by the path
+// of the property in its schema file (such as "common/user.schema.json#site_admin") and why
+var ignoredSchemaFields = map[string]string{}
+
+// TestSchemaMapping checks the payload type of every supported event against its schema,
+// following $refs into the shared definitions. Every field has to be in the schema with a type it
+// can be unmarshalled from, and every property of the schema has to have a field unless it is in
+// ignoredSchemaFields. Run "go generate ./logos/events" after changing a schema to regenerate the
+// types
+func TestSchemaMapping(t *testing.T) {
+	for event := range SupportedEvents {
+		if _, err := os.Stat(filepath.Join("schema", event+".schema.json")); err != nil {
+			t.Errorf("%s: no schema: %s", event, err)
+		}
+	}
+
+	files, err := filepath.Glob("schema/*.schema.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ignored = map[string]bool{}
+
+	for _, file := range files {
+		event := strings.TrimSuffix(filepath.Base(file), ".schema.json")
+
+		payload, ok := generatedPayloads[event]
+
+		if !ok {
+			t.Errorf("%s: no payload type for event %s", file, event)
+			continue
+		}
+
+		if _, ok := SupportedEvents[event]; !ok {
+			t.Errorf("%s: event %s has no renderer", file, event)
+		}
+
+		checkSchemaMapping(t, event, filepath.Base(file)+"#", loadSchema(t, filepath.Base(file)), reflect.TypeOf(payload), ignored)
+	}
+
+	for field := range ignoredSchemaFields {
+		if !ignored[field] {
+			t.Errorf("%s: ignored schema field is mapped or no longer in the schema", field)
+		}
+	}
+}
+
+// checkSchemaMapping compares a schema against the json tags and field types of a Go type. path
+// is where the value is in the payload, schemaPath where its schema is, and ignored collects the
+// entries of ignoredSchemaFields that were used
+func checkSchemaMapping(t *testing.T, path, schemaPath string, schema map[string]any, typ reflect.Type, ignored map[string]bool) {
+	t.Helper()
+
+	schema, ref := resolveSchema(t, schema)
+
+	if ref != "" {
+		schemaPath = ref + "#"
+	}
+
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	for _, jsonType := range schemaTypes(schema) {
+		if !acceptsType(typ, jsonType) {
+			t.Errorf("%s: the schema has type %s but the payload type is %s", path, jsonType, typ)
+			return
+		}
+	}
+
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array:
+		if items, ok := schema["items"].(map[string]any); ok {
+			checkSchemaMapping(t, path+"[]", schemaPath+"[]", items, typ.Elem(), ignored)
+		}
+
+		return
+	case reflect.Map:
+		if additional, ok := schema["additionalProperties"].(map[string]any); ok {
+			checkSchemaMapping(t, path+".*", schemaPath+"*", additional, typ.Elem(), ignored)
+		}
+
+		return
+	case reflect.Struct:
+	default:
+		return
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+
+	var fields = map[string]reflect.Type{}
+	for i := 0; i < typ.NumField(); i++ {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+
+		if name != "" && name != "-" {
+			fields[name] = typ.Field(i).Type
+		}
+	}
+
+	// Nested properties are separated by dots, the properties of a shared definition start
+	// right after the #
+	var prefix = schemaPath
+	if !strings.HasSuffix(prefix, "#") {
+		prefix += "."
+	}
+
+	for name, fieldType := range fields {
+		property, ok := properties[name].(map[string]any)
+
+		if !ok {
+			t.Errorf("%s.%s: payload field is not in the schema", path, name)
+			continue
+		}
+
+		checkSchemaMapping(t, path+"."+name, prefix+name, property, fieldType, ignored)
+	}
+
+	for name := range properties {
+		if _, ok := fields[name]; ok {
+			continue
+		}
+
+		if _, ok := ignoredSchemaFields[prefix+name]; ok {
+			ignored[prefix+name] = true
+			continue
+		}
+
+		t.Errorf("%s.%s: schema field %s has no mapping in the payload type", path, name, prefix+name)
+	}
+}
+
+// TestSchemaPayloads checks every sample payload in testdata against the schema of its event, so
+// that a schema cannot drift from what GitHub actually sends
+func TestSchemaPayloads(t *testing.T) {
+	for event := range SupportedEvents {
+		payloads, err := filepath.Glob(filepath.Join("testdata", event, "*.json"))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		schema := loadSchema(t, event+".schema.json")
+
+		for _, payload := range payloads {
+			body, err := os.ReadFile(payload)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var value any
+			if err := json.Unmarshal(body, &value); err != nil {
+				t.Fatalf("%s: %s", payload, err)
+			}
+
+			checkSchemaValue(t, payload, schema, value)
+		}
+	}
+}
+
+// checkSchemaValue checks the type of a JSON value, and that every property of an object is
+// in the schema
+func checkSchemaValue(t *testing.T, path string, schema map[string]any, value any) {
+	t.Helper()
+
+	var nullable bool
+	if oneOf, ok := schema["oneOf"].([]any); ok {
+		for _, alternative := range oneOf {
+			if alternative, ok := alternative.(map[string]any); ok && alternative["type"] == "null" {
+				nullable = true
+			}
+		}
+	}
+
+	schema, _ = resolveSchema(t, schema)
+
+	var jsonType string
+	switch value.(type) {
+	case nil:
+		if nullable || hasType(schema, "null") {
+			return
+		}
+
+		jsonType = "null"
+	case string:
+		jsonType = "string"
+	case bool:
+		jsonType = "boolean"
+	case float64:
+		jsonType = "number"
+	case []any:
+		jsonType = "array"
+	case map[string]any:
+		jsonType = "object"
+	}
+
+	// A schema without a type accepts any value
+	if schema["type"] == nil {
+		return
+	}
+
+	// Whole numbers are integers
+	if number, ok := value.(float64); ok && number == float64(int64(number)) && hasType(schema, "integer") {
+		jsonType = "integer"
+	}
+
+	if !hasType(schema, jsonType) {
+		t.Errorf("%s: value of type %s, the schema has %v", path, jsonType, schemaTypes(schema))
+		return
+	}
+
+	switch value := value.(type) {
+	case []any:
+		if items, ok := schema["items"].(map[string]any); ok {
+			for _, item := range value {
+				checkSchemaValue(t, path+"[]", items, item)
+			}
+		}
+	case map[string]any:
+		properties, _ := schema["properties"].(map[string]any)
+		additional, _ := schema["additionalProperties"].(map[string]any)
+
+		for name, property := range value {
+			switch {
+			case properties[name] != nil:
+				checkSchemaValue(t, path+"."+name, properties[name].(map[string]any), property)
+			case additional != nil:
+				checkSchemaValue(t, path+"."+name, additional, property)
+			case len(properties) > 0:
+				t.Errorf("%s.%s: property is not in the schema", path, name)
+			}
+		}
+	}
+}
+
+// hasType returns whether a schema allows a JSON type, null included
+func hasType(schema map[string]any, jsonType string) bool {
+	switch typ := schema["type"].(type) {
+	case string:
+		return typ == jsonType
+	case []any:
+		for _, v := range typ {
+			if v == jsonType {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,126 @@
+package events
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func secretScanningAlertFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh SecretScanningAlertEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	// Secrets have no severity of their own, a leaked secret is always treated as critical
+	// until it has been dealt with
+	var color int
+	switch gh.Action {
+	case "resolved", "revoked":
+		color = colorSuccess
+	default:
+		color = severityColor("critical")
+	}
+
+	var secretType = gh.Alert.SecretTypeDisplayName
+	if secretType == "" {
+		secretType = gh.Alert.SecretType
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Secret Type",
+			Value:  secretType,
+			Inline: true,
+		},
+		{
+			Name:   "State",
+			Value:  gh.Alert.State,
+			Inline: true,
+		},
+	}
+
+	if gh.Alert.Validity != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Validity",
+			Value:  gh.Alert.Validity,
+			Inline: true,
+		})
+	}
+
+	if gh.Alert.Resolution != "" {
+		var resolution = label(p, "Resolution") + " " + strings.ReplaceAll(gh.Alert.Resolution, "_", " ")
+
+		if gh.Alert.ResolutionComment != "" {
+			resolution += "\n" + label(p, "Comment") + " " + gh.Alert.ResolutionComment
+		}
+
+		if len(resolution) > 1000 {
+			resolution = resolution[:1000] + "..."
+		}
+
+		if gh.Alert.ResolvedBy != nil {
+			resolution += "\n" + label(p, "Resolved By") + " " + gh.Alert.ResolvedBy.Link()
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Resolution Details",
+			Value: resolution,
+		})
+	}
+
+	if gh.Alert.PushProtectionBypassed {
+		var bypass = p.Sprintf("This secret was pushed even though push protection blocked it")
+
+		if gh.Alert.PushProtectionBypassedBy != nil {
+			bypass += "\n" + label(p, "Bypassed By") + " " + gh.Alert.PushProtectionBypassedBy.Link()
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "⚠️ Push Protection Bypassed",
+			Value: bypass,
+		})
+	}
+
+	var embed = &discordgo.MessageEmbed{
+		Color:  color,
+		URL:    gh.Alert.HTMLURL,
+		Title:  p.Sprintf("Secret scanning alert #%s %s on %s", strconv.Itoa(gh.Alert.Number), translateAction(p, gh.Action), gh.Repository.FullName),
+		Fields: fields,
+	}
+
+	// Alerts created by a scan are sent by GitHub itself and have no sender
+	if gh.Sender.Login != "" {
+		embed.Author = gh.Sender.AuthorEmbed()
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{embed},
+	}, nil
+}
+
+func secretScanningAlertSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh SecretScanningAlertEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var secretType = gh.Alert.SecretTypeDisplayName
+	if secretType == "" {
+		secretType = gh.Alert.SecretType
+	}
+
+	var text = p.Sprintf("Secret scanning alert #%s %s: %s", strconv.Itoa(gh.Alert.Number), translateAction(p, gh.Action), secretType)
+
+	return summaryLine(gh.Repository.FullName, text, gh.Alert.HTMLURL), nil
+}
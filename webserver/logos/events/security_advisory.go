@@ -0,0 +1,151 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func securityAdvisoryFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh SecurityAdvisoryEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	advisory := gh.SecurityAdvisory
+
+	var color int
+	switch gh.Action {
+	case "withdrawn":
+		color = colorNeutral
+	case "published":
+		color = severityColor(advisory.Severity)
+	default:
+		color = colorWarning
+	}
+
+	var url = advisory.HTMLURL
+	if url == "" {
+		url = "https://github.com/advisories/" + advisory.GHSAID
+	}
+
+	var identifiers []string
+	for _, identifier := range advisory.Identifiers {
+		if identifier.Type == "CVE" {
+			identifiers = append(identifiers, "["+identifier.Value+"](https://nvd.nist.gov/vuln/detail/"+identifier.Value+")")
+		} else {
+			identifiers = append(identifiers, identifier.Value)
+		}
+	}
+
+	if len(identifiers) == 0 {
+		identifiers = append(identifiers, advisory.GHSAID)
+	}
+
+	var cvss = p.Sprintf("Not scored")
+	if advisory.CVSS.VectorString != "" {
+		cvss = fmt.Sprintf("%.1f ``%s``", advisory.CVSS.Score, advisory.CVSS.VectorString)
+	}
+
+	var packages string
+	for i, vuln := range advisory.Vulnerabilities {
+		var patched = vuln.FirstPatchedVersion.Identifier
+		if patched == "" {
+			patched = p.Sprintf("no patch yet")
+		}
+
+		line := p.Sprintf("**%s** (%s) %s, patched in %s\n", vuln.Package.Name, vuln.Package.Ecosystem, vuln.VulnerableVersionRange, patched)
+
+		if len(packages)+len(line) > 1000 {
+			packages += p.Sprintf("...and %d more", len(advisory.Vulnerabilities)-i)
+			break
+		}
+
+		packages += line
+	}
+
+	if packages == "" {
+		packages = p.Sprintf("No affected packages listed")
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Identifiers",
+			Value:  strings.Join(identifiers, ", "),
+			Inline: true,
+		},
+		{
+			Name:   "Severity",
+			Value:  advisory.Severity,
+			Inline: true,
+		},
+		{
+			Name:   "CVSS",
+			Value:  cvss,
+			Inline: true,
+		},
+		{
+			Name:  "Affected Packages",
+			Value: packages,
+		},
+	}
+
+	if len(advisory.Cwes) > 0 {
+		var cwes []string
+		for _, cwe := range advisory.Cwes {
+			cwes = append(cwes, cwe.CWEID+": "+cwe.Name)
+		}
+
+		var weaknesses = strings.Join(cwes, "\n")
+		if len(weaknesses) > 1000 {
+			weaknesses = weaknesses[:1000] + "..."
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Weaknesses",
+			Value: weaknesses,
+		})
+	}
+
+	var description = "**" + advisory.Summary + "**"
+	if advisory.Description != "" {
+		if len(advisory.Description) > 996 {
+			description += "\n\n" + advisory.Description[:996] + "..."
+		} else {
+			description += "\n\n" + advisory.Description
+		}
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:       color,
+				URL:         url,
+				Title:       p.Sprintf("Security advisory %s %s", advisory.GHSAID, translateAction(p, gh.Action)),
+				Description: description,
+				Fields:      fields,
+			},
+		},
+	}, nil
+}
+
+func securityAdvisorySummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh SecurityAdvisoryEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("Security advisory %s %s: %s", gh.SecurityAdvisory.GHSAID, translateAction(p, gh.Action), gh.SecurityAdvisory.Summary)
+
+	return summaryLine("", text, gh.SecurityAdvisory.HTMLURL), nil
+}
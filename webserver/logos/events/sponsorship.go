@@ -0,0 +1,131 @@
+package events
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+func (t SponsorshipTier) String() string {
+	if t.IsOneTime {
+		return t.Name + " (one time)"
+	}
+
+	return t.Name
+}
+
+// sponsorName returns the login of the sponsor of a sponsorship and whether it is private.
+// Private sponsors must never be named, the sender of a sponsorship event is the sponsor
+// themselves so it cannot be used either
+func sponsorName(gh SponsorshipEvent, p *message.Printer) (string, bool) {
+	if gh.Sponsorship.PrivacyLevel == "private" {
+		return p.Sprintf("A private sponsor"), true
+	}
+
+	return gh.Sponsorship.Sponsor.Login, false
+}
+
+func sponsorshipFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+	var gh SponsorshipEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return &discordgo.MessageSend{}, err
+	}
+
+	var sponsor, private = sponsorName(gh, p)
+
+	var sponsorable = gh.Sponsorship.Sponsorable.Login
+
+	var color int
+	var title string
+	switch gh.Action {
+	case "created":
+		color = colorSuccess
+		title = p.Sprintf("%s is now sponsoring %s", sponsor, sponsorable)
+	case "cancelled":
+		color = colorFailure
+		title = p.Sprintf("%s cancelled their sponsorship of %s", sponsor, sponsorable)
+	case "pending_cancellation":
+		color = colorFailure
+		title = p.Sprintf("%s will cancel their sponsorship of %s", sponsor, sponsorable)
+	case "tier_changed":
+		color = colorWarning
+		title = p.Sprintf("%s changed their sponsorship tier for %s", sponsor, sponsorable)
+	case "pending_tier_change":
+		color = colorWarning
+		title = p.Sprintf("%s will change their sponsorship tier for %s", sponsor, sponsorable)
+	default:
+		color = colorWarning
+		title = p.Sprintf("Sponsorship of %s %s", sponsorable, translateAction(p, gh.Action))
+	}
+
+	var tier = gh.Sponsorship.Tier.String()
+
+	if gh.Changes.Tier.From.Name != "" {
+		tier = gh.Changes.Tier.From.String() + " → " + tier
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Sponsor",
+			Value:  sponsor,
+			Inline: true,
+		},
+		{
+			Name:   "Tier",
+			Value:  tier,
+			Inline: true,
+		},
+	}
+
+	if !private {
+		fields[0].Value = gh.Sponsorship.Sponsor.Link()
+	}
+
+	if gh.EffectiveDate != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Effective",
+			Value:  formatDate(gh.EffectiveDate),
+			Inline: true,
+		})
+	}
+
+	var embed = &discordgo.MessageEmbed{
+		Color:  color,
+		URL:    "https://github.com/sponsors/" + sponsorable,
+		Title:  title,
+		Fields: fields,
+	}
+
+	if !private {
+		embed.Author = gh.Sponsorship.Sponsor.AuthorEmbed()
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{embed},
+	}, nil
+}
+
+func sponsorshipSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh SponsorshipEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var sponsor, private = sponsorName(gh, p)
+
+	var text string
+	if private {
+		text = p.Sprintf("Sponsorship of %s by a private sponsor %s: %s", gh.Sponsorship.Sponsorable.Login, translateAction(p, gh.Action), gh.Sponsorship.Tier.String())
+	} else {
+		text = p.Sprintf("Sponsorship of %s by %s %s: %s", gh.Sponsorship.Sponsorable.Login, sponsor, translateAction(p, gh.Action), gh.Sponsorship.Tier.String())
+	}
+
+	return summaryLine("", text, gh.Sponsorship.Sponsorable.HTMLURL), nil
+}
@@ -2,15 +2,10 @@ package events
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type StarEvent struct {
-	Action string     `json:"action"`
-	Repo   Repository `json:"repository"`
-	Sender User       `json:"sender"`
-}
-
-func starFn(bytes []byte) (*discordgo.MessageSend, error) {
+func starFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh StarEvent
 
 	// Unmarshal the JSON into our struct
@@ -23,17 +18,17 @@ func starFn(bytes []byte) (*discordgo.MessageSend, error) {
 	var color int
 	var title string
 	if gh.Action == "created" {
-		color = colorGreen
-		title = "Starred: " + gh.Repo.FullName
+		color = colorInfo
+		title = p.Sprintf("Starred: %s", gh.Repository.FullName)
 	} else {
-		color = colorRed
-		title = "Unstarred: " + gh.Repo.FullName
+		color = colorNeutral
+		title = p.Sprintf("Unstarred: %s", gh.Repository.FullName)
 	}
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
 				Color:  color,
-				URL:    gh.Repo.HTMLURL,
+				URL:    gh.Repository.HTMLURL,
 				Title:  title,
 				Author: gh.Sender.AuthorEmbed(),
 				Fields: []*discordgo.MessageEmbedField{
@@ -46,3 +41,21 @@ func starFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func starSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh StarEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s starred the repository", gh.Sender.Login)
+	if gh.Action == "deleted" {
+		text = p.Sprintf("%s unstarred the repository", gh.Sender.Login)
+	}
+
+	return summaryLine(gh.Repository.FullName, text, gh.Repository.HTMLURL), nil
+}
@@ -4,30 +4,10 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type StatusEvent struct {
-	Repo        Repository `json:"repository"`
-	Sender      User       `json:"sender"`
-	State       string     `json:"state"`
-	Description string     `json:"description"`
-	TargetURL   string     `json:"target_url"`
-	Context     string     `json:"context"`
-	Commit      struct {   // status
-		HTMLURL string `json:"html_url"`
-		SHA     string `json:"sha"`
-		Author  struct {
-			Login   string `json:"login"`
-			HTMLURL string `json:"html_url"` // user
-		} `json:"author"`
-		Commit struct {
-			ID      string `json:"id"`
-			Message string `json:"message"`
-		} `json:"commit"`
-	} `json:"commit"`
-}
-
-func statusFn(bytes []byte) (*discordgo.MessageSend, error) {
+func statusFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh StatusEvent
 
 	// Unmarshal the JSON into our struct
@@ -42,6 +22,16 @@ func statusFn(bytes []byte) (*discordgo.MessageSend, error) {
 		moreInfoMsg = "\n\nFor more information, " + gh.TargetURL
 	}
 
+	var color int
+	switch gh.State {
+	case "success":
+		color = colorSuccess
+	case "failure", "error":
+		color = colorFailure
+	default:
+		color = colorWarning
+	}
+
 	if gh.Context == "" {
 		gh.Context = "-"
 	}
@@ -49,15 +39,15 @@ func statusFn(bytes []byte) (*discordgo.MessageSend, error) {
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:       colorGreen,
-				URL:         gh.Repo.HTMLURL,
+				Color:       color,
+				URL:         gh.Repository.HTMLURL,
 				Author:      gh.Sender.AuthorEmbed(),
-				Title:       "Status " + gh.State + " on " + gh.Repo.FullName,
+				Title:       p.Sprintf("Status %s on %s", translate(p, gh.State), gh.Repository.FullName),
 				Description: gh.Description + moreInfoMsg,
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:  "Commit",
-						Value: fmt.Sprintf("[``%s``](%s) - %s | [%s](%s)", gh.Commit.SHA[:7], gh.Commit.HTMLURL, gh.Commit.Commit.Message, gh.Commit.Author.Login, gh.Commit.Author.HTMLURL),
+						Value: fmt.Sprintf("[``%s``](%s) - %s | [%s](%s)", shortSHA(gh.Commit.SHA), gh.Commit.HTMLURL, gh.Commit.Commit.Message, gh.Commit.Author.Login, gh.Commit.Author.HTMLURL),
 					},
 					{
 						Name:   "User",
@@ -74,3 +64,23 @@ func statusFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func statusSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh StatusEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("Status %s on %s: %s", translate(p, gh.State), shortSHA(gh.Commit.SHA), gh.Context)
+
+	var url = gh.TargetURL
+	if url == "" {
+		url = gh.Commit.HTMLURL
+	}
+
+	return summaryLine(gh.Repository.FullName, text, url), nil
+}
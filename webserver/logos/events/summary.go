@@ -0,0 +1,137 @@
+package events
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
+)
+
+// Summaries of every event in SupportedEvents, which can say more in one line than the title of their embed does
+var Summaries = map[string]func(bytes []byte, p *message.Printer) (string, error){
+	"push":                           pushSummary,
+	"pull_request":                   pullRequestSummary,
+	"pull_request_review":            pullRequestReviewSummary,
+	"pull_request_review_comment":    pullRequestReviewCommentSummary,
+	"issues":                         issuesSummary,
+	"issue_comment":                  issueCommentSummary,
+	"commit_comment":                 commitCommentSummary,
+	"create":                         createSummary,
+	"delete":                         deleteSummary,
+	"release":                        releaseSummary,
+	"workflow_run":                   workflowRunSummary,
+	"star":                           starSummary,
+	"fork":                           forkSummary,
+	"branch_protection_rule":         branchProtectionRuleSummary,
+	"check_suite":                    checkSuiteSummary,
+	"status":                         statusSummary,
+	"deployment":                     deploymentSummary,
+	"deployment_status":              deploymentStatusSummary,
+	"discussion":                     discussionSummary,
+	"discussion_comment":             discussionCommentSummary,
+	"dependabot_alert":               dependabotAlertSummary,
+	"workflow_job":                   workflowJobSummary,
+	"check_run":                      checkRunSummary,
+	"public":                         publicSummary,
+	"watch":                          watchSummary,
+	"repository":                     repositorySummary,
+	"team":                           teamSummary,
+	"page_build":                     pageBuildSummary,
+	"repository_vulnerability_alert": repositoryVulnerabilityAlertSummary,
+	"code_scanning_alert":            codeScanningAlertSummary,
+	"secret_scanning_alert":          secretScanningAlertSummary,
+	"label":                          labelSummary,
+	"milestone":                      milestoneSummary,
+	"member":                         memberSummary,
+	"membership":                     membershipSummary,
+	"package":                        packageSummary,
+	"registry_package":               registryPackageSummary,
+	"merge_group":                    mergeGroupSummary,
+	"repository_ruleset":             repositoryRulesetSummary,
+	"gollum":                         gollumSummary,
+	"sponsorship":                    sponsorshipSummary,
+	"security_advisory":              securityAdvisorySummary,
+	"installation":                   installationSummary,
+	"installation_repositories":      installationRepositoriesSummary,
+	"github_app_authorization":       githubAppAuthorizationSummary,
+	"organization":                   organizationSummary,
+	"org_block":                      orgBlockSummary,
+	"project":                        projectSummary,
+	"projects_v2_item":               projectsV2ItemSummary,
+}
+
+// Summary returns a plain-text, one-line summary of an event such as
+// "[org/repo] alice pushed 3 commits to main: fix x, add y…" for channels that prefer
+// one-liners to embeds. Events without their own summary are summarized by the title
+// of their rendered message
+func Summary(event string, bytes []byte, messageSend *discordgo.MessageSend, p *message.Printer) string {
+	if summaryFn, ok := Summaries[event]; ok {
+		if line, err := summaryFn(bytes, p); err == nil && line != "" {
+			return line
+		}
+	}
+
+	var gh struct {
+		RepoWrapper
+		Sender User `json:"sender"`
+	}
+
+	// A payload that cannot be unmarshalled has already failed to render
+	_ = json.Unmarshal(bytes, &gh)
+
+	var text, url string
+	if len(messageSend.Embeds) > 0 {
+		text = messageSend.Embeds[0].Title
+		url = messageSend.Embeds[0].URL
+	}
+
+	if text == "" {
+		text = event
+	}
+
+	if gh.Sender.Login != "" {
+		text = gh.Sender.Login + ": " + text
+	}
+
+	return summaryLine(gh.RouteName(), text, url)
+}
+
+// summaryLine prefixes a summary with the repository (or organization) it is about and
+// links to the event, in angle brackets so that Discord does not preview the link
+func summaryLine(where, text, url string) string {
+	if where != "" {
+		text = "[" + where + "] " + text
+	}
+
+	if url != "" {
+		text += " <" + url + ">"
+	}
+
+	return text
+}
+
+// snippet returns the first line of a message or markdown body, cut to about n bytes
+func snippet(s string, n int) string {
+	s, _, _ = strings.Cut(convertMarkdown(s), "\n")
+	s = strings.TrimSpace(s)
+
+	if len(s) <= n {
+		return s
+	}
+
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return strings.TrimSpace(s[:n]) + "…"
+}
+
+// commentSummary summarizes a comment on an issue, pull request or commit with the start of its body
+func commentSummary(p *message.Printer, action, login, on, body string) string {
+	if action != "created" {
+		return p.Sprintf("%s %s a comment on %s", login, translateAction(p, action), on)
+	}
+
+	return p.Sprintf("%s commented on %s: %s", login, on, snippet(body, 150))
+}
@@ -0,0 +1,122 @@
+package events
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// summaryOf renders a payload and returns its one-line summary
+func summaryOf(t *testing.T, payload string, locale string) string {
+	t.Helper()
+
+	event := filepath.Base(filepath.Dir(payload))
+
+	bytes, err := os.ReadFile(payload)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messageSend, err := SupportedEvents[event](bytes, Printer(locale))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return Summary(event, bytes, messageSend, Printer(locale))
+}
+
+func TestSummary(t *testing.T) {
+	for payload, want := range map[string]string{
+		"testdata/push/push.json":                              "[binkkatal/sample_app] binkkatal pushed 1 commit to master: test a push event <https://github.com/binkkatal/sample_app/compare/737d38c599c1...fd489864e764>",
+		"testdata/push/branch_deleted.json":                    "[binkkatal/sample_app] binkkatal deleted feature/login <https://github.com/binkkatal/sample_app>",
+		"testdata/sponsorship/created_private.json":            "Sponsorship of jasonrudolph by a private sponsor created: $5 a month <https://github.com/jasonrudolph>",
+		"testdata/pull_request/closed_merged.json":             "[baxterthehacker/public-repo] baxterthehacker merged pull request #1: Update the README with new information <https://github.com/baxterthehacker/public-repo/pull/1>",
+		"testdata/pull_request_review/submitted_approved.json": "[baxterthehacker/public-repo] baxterthehacker approved #8: Add a README description <https://github.com/baxterthehacker/public-repo/pull/8#pullrequestreview-2626884>",
+		"testdata/issue_comment/deleted.json":                  "[baxterthehacker/public-repo] baxterthehacker deleted a comment on #2 <https://github.com/baxterthehacker/public-repo/issues/2#issuecomment-99262140>",
+		"testdata/workflow_run/failure.json":                   "[octo-org/octo-repo] Workflow Build #562 failed on master <https://github.com/octo-org/octo-repo/actions/runs/30433642>",
+		"testdata/branch_protection_rule/deleted.json":         "[baxterthehacker/public-repo] baxterthehacker deleted branch protection rule main <https://github.com/baxterthehacker/public-repo/settings/branches>",
+		"testdata/organization/renamed.json":                   "[baxterandthehackers] baxterthehacker renamed the organization <https://github.com/baxterandthehackers>",
+	} {
+		if got := summaryOf(t, payload, "en"); got != want {
+			t.Errorf("%s:\n got %q\nwant %q", payload, got, want)
+		}
+	}
+
+	// Truncated pushes list only the first commits
+	if got, want := summaryOf(t, "testdata/push/truncated.json", "en"), "[binkkatal/sample_app] binkkatal pushed 20+ commits to master: "; !strings.HasPrefix(got, want) {
+		t.Errorf("truncated push:\n got %q\nwant prefix %q", got, want)
+	}
+
+	// Translations can reorder the parts of a summary
+	if got, want := summaryOf(t, "testdata/pull_request/closed_merged.json", "de"), "[baxterthehacker/public-repo] baxterthehacker hat Pull Request #1 zusammengeführt: Update the README with new information <https://github.com/baxterthehacker/public-repo/pull/1>"; got != want {
+		t.Errorf("de:\n got %q\nwant %q", got, want)
+	}
+}
+
+// TestSummaryOneLine checks every payload in testdata is summarized on a single line
+func TestSummaryOneLine(t *testing.T) {
+	payloads, err := filepath.Glob("testdata/*/*.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, payload := range payloads {
+		if _, ok := SupportedEvents[filepath.Base(filepath.Dir(payload))]; !ok {
+			continue
+		}
+
+		if got := summaryOf(t, payload, "en"); got == "" || strings.Contains(got, "\n") || len(got) > 2000 {
+			t.Errorf("%s: not a one-line summary: %q", payload, got)
+		}
+	}
+}
+
+// TestSummaryCoverage checks every supported event has its own summary, which summarizes
+// every payload of that event in testdata without falling back to the title of its embed
+func TestSummaryCoverage(t *testing.T) {
+	for event := range SupportedEvents {
+		if _, ok := Summaries[event]; !ok {
+			t.Errorf("%s: no summary", event)
+		}
+	}
+
+	payloads, err := filepath.Glob("testdata/*/*.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, payload := range payloads {
+		summaryFn, ok := Summaries[filepath.Base(filepath.Dir(payload))]
+
+		if !ok {
+			continue
+		}
+
+		bytes, err := os.ReadFile(payload)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if line, err := summaryFn(bytes, Printer("en")); err != nil || line == "" {
+			t.Errorf("%s: summary %q, error %v", payload, line, err)
+		}
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	for input, want := range map[string]string{
+		"Fix the build\n\nLonger description": "Fix the build",
+		"<!-- hint -->\nActual text":          "Actual text",
+		"ünïcödé ünïcödé":                     "ünïcödé…",
+	} {
+		if got := snippet(input, 13); got != want {
+			t.Errorf("snippet(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
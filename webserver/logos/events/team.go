@@ -2,28 +2,12 @@ package events
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type TeamEvent struct {
-	Action string     `json:"action"`
-	Repo   Repository `json:"repository"`
-	Sender User       `json:"sender"`
-	Team   struct {
-		Name        string `json:"name"`
-		ID          int    `json:"id"`
-		Slug        string `json:"slug"`
-		Deleted     bool   `json:"deleted"`
-		HTMLUrl     string `json:"html_url"`
-		Permission  string `json:"permission"`
-		Privacy     string `json:"privacy"`
-		Description string `json:"description"`
-	} `json:"team"`
-}
-
-func teamFn(bytes []byte) (*discordgo.MessageSend, error) {
+func teamFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh TeamEvent
 	// Unmarshal the JSON into our struct
 	err := json.Unmarshal(bytes, &gh)
@@ -34,9 +18,9 @@ func teamFn(bytes []byte) (*discordgo.MessageSend, error) {
 
 	var color int
 	if gh.Action != "deleted" && gh.Action != "removed_from_repository" {
-		color = colorGreen
+		color = colorSuccess
 	} else {
-		color = colorRed
+		color = colorFailure
 	}
 
 	var teamNameSlugged = gh.Team.Name
@@ -52,7 +36,7 @@ func teamFn(bytes []byte) (*discordgo.MessageSend, error) {
 	}
 
 	if description == "" {
-		description = "No description provided."
+		description = p.Sprintf("No description provided.")
 	}
 
 	var permission = gh.Team.Permission
@@ -62,7 +46,7 @@ func teamFn(bytes []byte) (*discordgo.MessageSend, error) {
 	}
 
 	if permission == "" {
-		permission = "No permissions provided."
+		permission = p.Sprintf("No permissions provided.")
 	}
 
 	var privacy = gh.Team.Privacy
@@ -72,20 +56,31 @@ func teamFn(bytes []byte) (*discordgo.MessageSend, error) {
 	}
 
 	if privacy == "" {
-		privacy = "No privacy settings set."
+		privacy = p.Sprintf("No privacy settings set.")
+	}
+
+	// Team events are organization-level unless a repository was added or removed
+	var url = gh.Repository.HTMLURL
+	var title = p.Sprintf("Team %s", translateAction(p, gh.Action))
+
+	if gh.Repository.FullName != "" {
+		title += " " + gh.Repository.FullName
+	} else if gh.Organization.Login != "" {
+		url = gh.Team.HTMLURL
+		title += p.Sprintf(" in %s", gh.Organization.Login)
 	}
 
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
 				Color:  color,
-				URL:    gh.Repo.HTMLURL,
+				URL:    url,
 				Author: gh.Sender.AuthorEmbed(),
-				Title:  "Team " + strings.Replace(gh.Action, "_", " ", -1),
+				Title:  title,
 				Fields: []*discordgo.MessageEmbedField{
 					{
 						Name:  "Team",
-						Value: fmt.Sprintf("[%s](%s)", teamNameSlugged, gh.Team.HTMLUrl),
+						Value: fmt.Sprintf("[%s](%s)", teamNameSlugged, gh.Team.HTMLURL),
 					},
 					{
 						Name:  "User",
@@ -105,3 +100,18 @@ func teamFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func teamSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh TeamEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text = p.Sprintf("%s %s team %s", gh.Sender.Login, translateAction(p, gh.Action), gh.Team.Name)
+
+	return summaryLine(RepoWrapper{Repo: gh.Repository, Org: gh.Organization}.RouteName(), text, gh.Team.HTMLURL), nil
+}
@@ -2,15 +2,10 @@ package events
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type WatchEvent struct {
-	Action string     `json:"action"`
-	Repo   Repository `json:"repository"`
-	Sender User       `json:"sender"`
-}
-
-func watchFn(bytes []byte) (*discordgo.MessageSend, error) {
+func watchFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh WatchEvent
 
 	// Unmarshal the JSON into our struct
@@ -22,13 +17,13 @@ func watchFn(bytes []byte) (*discordgo.MessageSend, error) {
 
 	var color int
 	var title string
-	color = colorGreen
-	title = "Watch " + gh.Action + ": " + gh.Repo.FullName
+	color = colorInfo
+	title = p.Sprintf("Watch %s: %s", translateAction(p, gh.Action), gh.Repository.FullName)
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
 				Color:  color,
-				URL:    gh.Repo.HTMLURL,
+				URL:    gh.Repository.HTMLURL,
 				Title:  title,
 				Author: gh.Sender.AuthorEmbed(),
 				Fields: []*discordgo.MessageEmbedField{
@@ -41,3 +36,16 @@ func watchFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 	}, nil
 }
+
+func watchSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh WatchEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	return summaryLine(gh.Repository.FullName, p.Sprintf("%s started watching the repository", gh.Sender.Login), gh.Repository.HTMLURL), nil
+}
@@ -2,48 +2,37 @@ package events
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 
 	"strconv"
+	"strings"
 )
 
-type WorkflowJobEvent struct {
-	Action      string     `json:"action"`
-	Repo        Repository `json:"repository"`
-	Sender      User       `json:"sender"`
-	WorkflowJob struct {
-		ID           int    `json:"id"`
-		RunID        int    `json:"run_id"`
-		WorkflowName string `json:"workflow_name"`
-		HeadBranch   string `json:"head_branch"`
-		RunURL       string `json:"run_url"`
-		RunAttempt   int    `json:"run_attempt"`
-		NodeID       string `json:"node_id"`
-		HeadSHA      string `json:"head_sha"`
-		URL          string `json:"url"`
-		HTMLURL      string `json:"html_url"`
-		Status       string `json:"status"`
-		Conclusion   string `json:"conclusion"`
-		StartedAt    string `json:"started_at"`
-		CompletedAt  string `json:"completed_at"`
-		Name         string `json:"name"`
-		Steps        []struct {
-			Name        string `json:"name"`
-			Status      string `json:"status"`
-			Conclusion  string `json:"conclusion"`
-			Number      int    `json:"number"`
-			StartedAt   string `json:"started_at"`
-			CompletedAt string `json:"completed_at"`
-		} `json:"steps"`
-		CheckRunUrl     string   `json:"check_run_url"`
-		Labels          []string `json:"labels"`
-		RunnerID        int      `json:"runner_id"`
-		RunnerName      string   `json:"runner_name"`
-		RunnerGroupID   int      `json:"runner_group_id"`
-		RunnerGroupName string   `json:"runner_group_name"`
-	} `json:"workflow_job"`
+// stepEmoji returns the emoji shown next to a workflow job step
+func stepEmoji(status, conclusion string) string {
+	if status != "completed" {
+		if status == "in_progress" {
+			return "🔄"
+		}
+
+		return "⏳"
+	}
+
+	switch conclusion {
+	case "success":
+		return "✅"
+	case "failure", "timed_out":
+		return "❌"
+	case "skipped":
+		return "⏭️"
+	case "cancelled":
+		return "⏹️"
+	default:
+		return "➖"
+	}
 }
 
-func workflowJobFn(bytes []byte) (*discordgo.MessageSend, error) {
+func workflowJobFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh WorkflowJobEvent
 
 	// Unmarshal the JSON into our struct
@@ -53,18 +42,25 @@ func workflowJobFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
-	if gh.WorkflowJob.Conclusion == "" {
-		gh.WorkflowJob.Conclusion = "No conclusion yet!"
+	var job = gh.WorkflowJob
+
+	var url = job.HTMLURL
+	if url == "" {
+		url = gh.Repository.HTMLURL + "/actions/runs/" + strconv.Itoa(job.RunID)
 	}
 
-	if gh.WorkflowJob.Status == "" {
-		gh.WorkflowJob.Status = "No status yet!"
+	if job.Conclusion == "" {
+		job.Conclusion = p.Sprintf("No conclusion yet!")
+	}
+
+	if job.Status == "" {
+		job.Status = p.Sprintf("No status yet!")
 	}
 
 	var fields = []*discordgo.MessageEmbedField{
 		{
 			Name:   "Workflow Name",
-			Value:  gh.WorkflowJob.WorkflowName,
+			Value:  job.WorkflowName,
 			Inline: true,
 		},
 		{
@@ -74,51 +70,105 @@ func workflowJobFn(bytes []byte) (*discordgo.MessageSend, error) {
 		},
 		{
 			Name:   "Status",
-			Value:  gh.WorkflowJob.Status,
+			Value:  job.Status,
 			Inline: true,
 		},
 		{
 			Name:   "Conclusion",
-			Value:  gh.WorkflowJob.Conclusion,
+			Value:  job.Conclusion,
 			Inline: true,
 		},
 		{
 			Name:   "Branch",
-			Value:  gh.WorkflowJob.HeadBranch,
+			Value:  job.HeadBranch,
 			Inline: true,
 		},
-		{
-			Name:   "URL",
-			Value:  gh.WorkflowJob.HTMLURL,
+	}
+
+	if job.RunAttempt > 1 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Attempt",
+			Value:  strconv.Itoa(job.RunAttempt),
 			Inline: true,
-		},
+		})
+	}
+
+	if duration := formatDuration(job.StartedAt, job.CompletedAt); duration != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Duration",
+			Value:  duration,
+			Inline: true,
+		})
+	}
+
+	if job.RunnerName != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Runner",
+			Value:  job.RunnerName,
+			Inline: true,
+		})
 	}
 
-	for _, step := range gh.WorkflowJob.Steps {
-		if step.Conclusion == "" {
-			step.Conclusion = "No conclusion yet!"
+	var steps string
+	var failed []string
+	for _, step := range job.Steps {
+		if step.Conclusion == "failure" || step.Conclusion == "timed_out" {
+			failed = append(failed, "step '"+step.Name+"'")
 		}
 
-		if step.Status == "" {
-			step.Status = "No status yet!"
+		if len(steps) > 900 {
+			continue
 		}
 
+		steps += stepEmoji(step.Status, step.Conclusion) + " " + strconv.Itoa(step.Number) + ". " + step.Name + "\n"
+	}
+
+	if len(steps) > 900 {
+		steps += "..."
+	}
+
+	if steps != "" {
 		fields = append(fields, &discordgo.MessageEmbedField{
-			Name:   "Step " + strconv.Itoa(step.Number) + " (" + step.Name + ")",
-			Value:  "Status: " + step.Status + "\nConclusion: " + step.Conclusion,
-			Inline: true,
+			Name:  "Steps",
+			Value: steps,
 		})
 	}
 
+	var description string
+	if len(failed) > 0 {
+		description = p.Sprintf("Failed at: %s", strings.Join(failed, ", "))
+	}
+
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:  colorGreen,
-				URL:    gh.Repo.HTMLURL,
-				Author: gh.Sender.AuthorEmbed(),
-				Title:  "Workflow Job: " + gh.WorkflowJob.Name,
-				Fields: fields,
+				Color:       conclusionColor(gh.WorkflowJob.Status, gh.WorkflowJob.Conclusion),
+				URL:         url,
+				Author:      gh.Sender.AuthorEmbed(),
+				Title:       p.Sprintf("Workflow Job: %s %s on %s", job.Name, translate(p, workflowState(gh.WorkflowJob.Status, gh.WorkflowJob.Conclusion)), gh.Repository.FullName),
+				Description: description,
+				Fields:      fields,
 			},
 		},
 	}, nil
 }
+
+func workflowJobSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh WorkflowJobEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var result = gh.WorkflowJob.Conclusion
+	if result == "" {
+		result = gh.Action
+	}
+
+	var text = p.Sprintf("Job %s of %s %s on %s", gh.WorkflowJob.Name, gh.WorkflowJob.WorkflowName, translateAction(p, result), gh.WorkflowJob.HeadBranch)
+
+	return summaryLine(gh.Repository.FullName, text, gh.WorkflowJob.HTMLURL), nil
+}
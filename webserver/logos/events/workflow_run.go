@@ -2,37 +2,35 @@ package events
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"golang.org/x/text/message"
 )
 
-type WorkflowRunEvent struct {
-	Action      string     `json:"action"`
-	Repo        Repository `json:"repository"`
-	Sender      User       `json:"sender"`
-	WorkflowRun struct {
-		ID              int    `json:"id"`
-		HeadBranch      string `json:"head_branch"`
-		HeadSHA         string `json:"head_sha"`
-		RunNumber       int    `json:"run_number"`
-		Event           string `json:"event"`
-		Name            string `json:"name"`
-		Status          string `json:"status"`
-		Conclusion      string `json:"conclusion"`
-		URL             string `json:"url"`
-		TriggeringActor User   `json:"triggering_actor"`
-		HeadCommit      struct {
-			ID        string `json:"id"`
-			TreeID    string `json:"tree_id"`
-			Message   string `json:"message"`
-			Timestamp string `json:"timestamp"`
-			Author    User   `json:"author"`
-			Committer User   `json:"committer"`
-		} `json:"head_commit"`
-	} `json:"workflow_run"`
+// workflowState returns a human readable state for a workflow run or job, which is its
+// conclusion once it has completed
+func workflowState(status, conclusion string) string {
+	if status == "completed" && conclusion != "" {
+		switch conclusion {
+		case "success":
+			return "succeeded"
+		case "failure", "startup_failure":
+			return "failed"
+		}
+
+		return strings.ReplaceAll(conclusion, "_", " ")
+	}
+
+	if status == "" {
+		return "requested"
+	}
+
+	return strings.ReplaceAll(status, "_", " ")
 }
 
-func workflowRunFn(bytes []byte) (*discordgo.MessageSend, error) {
+func workflowRunFn(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
 	var gh WorkflowRunEvent
 
 	// Unmarshal the JSON into our struct
@@ -42,68 +40,133 @@ func workflowRunFn(bytes []byte) (*discordgo.MessageSend, error) {
 		return &discordgo.MessageSend{}, err
 	}
 
-	if gh.WorkflowRun.Conclusion == "" {
-		gh.WorkflowRun.Conclusion = "No conclusion yet!"
+	var run = gh.WorkflowRun
+
+	var url = run.HTMLURL
+	if url == "" {
+		url = gh.Repository.HTMLURL + "/actions/runs/" + strconv.Itoa(run.ID)
+	}
+
+	var runNumber = fmt.Sprintf("#%d", run.RunNumber)
+	if run.RunAttempt > 1 {
+		runNumber += p.Sprintf(" (attempt %d)", run.RunAttempt)
+	}
+
+	if run.Conclusion == "" {
+		run.Conclusion = p.Sprintf("No conclusion yet!")
+	}
+
+	if run.Status == "" {
+		run.Status = p.Sprintf("No status yet!")
+	}
+
+	var fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "User",
+			Value:  gh.Sender.Link(),
+			Inline: true,
+		},
+		{
+			Name:   "Status",
+			Value:  run.Status,
+			Inline: true,
+		},
+		{
+			Name:   "Conclusion",
+			Value:  run.Conclusion,
+			Inline: true,
+		},
+		{
+			Name:   "Branch",
+			Value:  run.HeadBranch,
+			Inline: true,
+		},
+	}
+
+	if len(run.HeadSHA) >= 7 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Commit",
+			Value:  gh.Repository.Commit(run.HeadSHA),
+			Inline: true,
+		})
+	}
+
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:   "Event",
+		Value:  run.Event,
+		Inline: true,
+	}, &discordgo.MessageEmbedField{
+		Name:   "Run Number",
+		Value:  runNumber,
+		Inline: true,
+	})
+
+	if gh.WorkflowRun.Status == "completed" {
+		if duration := formatDuration(run.RunStartedAt, run.UpdatedAt); duration != "" {
+			fields = append(fields, &discordgo.MessageEmbedField{
+				Name:   "Duration",
+				Value:  duration,
+				Inline: true,
+			})
+		}
+	}
+
+	if len(run.PullRequests) > 0 {
+		var prs []string
+		for _, pr := range run.PullRequests {
+			prs = append(prs, fmt.Sprintf("[#%d](%s/pull/%d)", pr.Number, gh.Repository.HTMLURL, pr.Number))
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Pull Requests",
+			Value:  strings.Join(prs, ", "),
+			Inline: true,
+		})
 	}
 
-	if gh.WorkflowRun.Status == "" {
-		gh.WorkflowRun.Status = "No status yet!"
+	if run.TriggeringActor.Login != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Triggered By",
+			Value: run.TriggeringActor.Link(),
+		})
+	}
+
+	var description string
+	if run.HeadCommit != nil {
+		description = run.HeadCommit.Message
+	}
+
+	if len(description) > 996 {
+		description = description[:996] + "..."
 	}
 
 	return &discordgo.MessageSend{
 		Embeds: []*discordgo.MessageEmbed{
 			{
-				Color:  colorGreen,
-				URL:    gh.Repo.HTMLURL,
-				Author: gh.Sender.AuthorEmbed(),
-				Title:  "Workflow Run: " + gh.WorkflowRun.Name,
-				Fields: []*discordgo.MessageEmbedField{
-					{
-						Name:   "User",
-						Value:  gh.Sender.Link(),
-						Inline: true,
-					},
-					{
-						Name:   "Status",
-						Value:  gh.WorkflowRun.Status,
-						Inline: true,
-					},
-					{
-						Name:   "Conclusion",
-						Value:  gh.WorkflowRun.Conclusion,
-						Inline: true,
-					},
-					{
-						Name:   "Branch",
-						Value:  gh.WorkflowRun.HeadBranch,
-						Inline: true,
-					},
-					{
-						Name:   "Commit",
-						Value:  gh.Repo.Commit(gh.WorkflowRun.HeadCommit.ID),
-						Inline: true,
-					},
-					{
-						Name:   "URL",
-						Value:  gh.WorkflowRun.URL,
-						Inline: true,
-					},
-					{
-						Name:   "Event",
-						Value:  gh.WorkflowRun.Event,
-						Inline: true,
-					},
-					{
-						Name:   "Run Number",
-						Value:  fmt.Sprintf("%d", gh.WorkflowRun.RunNumber),
-						Inline: true,
-					},
-					{
-						Name:  "Triggered By",
-						Value: gh.WorkflowRun.TriggeringActor.Link(),
-					},
-				},
+				Color:       conclusionColor(gh.WorkflowRun.Status, gh.WorkflowRun.Conclusion),
+				URL:         url,
+				Author:      gh.Sender.AuthorEmbed(),
+				Title:       p.Sprintf("Workflow Run: %s %s on %s", run.Name, translate(p, workflowState(gh.WorkflowRun.Status, gh.WorkflowRun.Conclusion)), gh.Repository.FullName),
+				Description: description,
+				Fields:      fields,
 			},
 		},
 	}, nil
 }
+
+func workflowRunSummary(bytes []byte, p *message.Printer) (string, error) {
+	var gh WorkflowRunEvent
+
+	// Unmarshal the JSON into our struct
+	err := json.Unmarshal(bytes, &gh)
+
+	if err != nil {
+		return "", err
+	}
+
+	var run = gh.WorkflowRun
+
+	var text = p.Sprintf("Workflow %s #%s %s on %s", run.Name, strconv.Itoa(run.RunNumber), translate(p, workflowState(run.Status, run.Conclusion)), run.HeadBranch)
+
+	return summaryLine(gh.Repository.FullName, text, run.HTMLURL), nil
+}
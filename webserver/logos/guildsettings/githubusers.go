@@ -0,0 +1,35 @@
+package guildsettings
+
+import (
+	"github.com/git-logs/client/webserver/state"
+)
+
+// GetDiscordUsers returns the Discord user IDs mapped to the (lowercased) GitHub logins in a
+// guild, logins without a mapping are skipped
+func GetDiscordUsers(guildId string, logins []string) ([]string, error) {
+	if len(logins) == 0 {
+		return nil, nil
+	}
+
+	rows, err := state.Pool.Query(state.Context, "SELECT DISTINCT discord_id FROM "+state.TableGithubUsers+" WHERE guild_id = $1 AND github_login = ANY($2)", guildId, logins)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var ids []string
+
+	for rows.Next() {
+		var id string
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
@@ -0,0 +1,89 @@
+// Package guildsettings provides the per-guild settings used when handling events
+package guildsettings
+
+import (
+	"github.com/git-logs/client/webserver/state"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// What to do with messages that are over the rate limit
+const (
+	// Wait until the message can be sent
+	RateLimitPolicyQueue = "queue"
+
+	// Collapse the messages into one summary which is sent once the rate limit allows it
+	RateLimitPolicyCollapse = "collapse"
+
+	// Drop the message, noting it in the audit log
+	RateLimitPolicyDrop = "drop"
+)
+
+// How messages are laid out
+const (
+	// Embeds with a field for every detail
+	EmbedStyleFull = "full"
+
+	// Embeds with the details on a single line of the description
+	EmbedStyleCompact = "compact"
+)
+
+type GuildSettings struct {
+	Banned           bool
+	RateLimitPolicy  string
+	ChannelRateLimit int
+	WebhookRateLimit int
+	RateLimitBurst   int
+	FallbackChannel  string
+	Locale           string
+	EmbedStyle       string
+
+	// Quotas, nil if the guild has no quota
+	MaxDeliveriesPerHour *int
+	MaxWebhooks          *int
+	MaxRepos             *int
+
+	// Colors overriding the default palette, nil keeps the default
+	ColorSuccess *int
+	ColorFailure *int
+	ColorWarning *int
+	ColorNeutral *int
+	ColorInfo    *int
+	ColorSevere  *int
+}
+
+func GetGuildSettings(guildId string) (*GuildSettings, error) {
+	var s GuildSettings
+	var fallbackChannel pgtype.Text
+	var quotas [3]pgtype.Int4
+	var colors [6]pgtype.Int4
+
+	err := state.Pool.QueryRow(state.Context, "SELECT banned, rate_limit_policy, channel_rate_limit, webhook_rate_limit, rate_limit_burst, fallback_channel, max_deliveries_per_hour, max_webhooks, max_repos, locale, embed_style, color_success, color_failure, color_warning, color_neutral, color_info, color_severe FROM "+state.TableGuilds+" WHERE id = $1", guildId).Scan(&s.Banned, &s.RateLimitPolicy, &s.ChannelRateLimit, &s.WebhookRateLimit, &s.RateLimitBurst, &fallbackChannel, &quotas[0], &quotas[1], &quotas[2], &s.Locale, &s.EmbedStyle, &colors[0], &colors[1], &colors[2], &colors[3], &colors[4], &colors[5])
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.FallbackChannel = fallbackChannel.String
+	s.MaxDeliveriesPerHour = nullable(quotas[0])
+	s.MaxWebhooks = nullable(quotas[1])
+	s.MaxRepos = nullable(quotas[2])
+	s.ColorSuccess = nullable(colors[0])
+	s.ColorFailure = nullable(colors[1])
+	s.ColorWarning = nullable(colors[2])
+	s.ColorNeutral = nullable(colors[3])
+	s.ColorInfo = nullable(colors[4])
+	s.ColorSevere = nullable(colors[5])
+
+	return &s, nil
+}
+
+// nullable returns a nullable column as a pointer, nil if it is NULL
+func nullable(v pgtype.Int4) *int {
+	if !v.Valid {
+		return nil
+	}
+
+	q := int(v.Int32)
+	return &q
+}
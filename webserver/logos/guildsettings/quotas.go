@@ -0,0 +1,57 @@
+package guildsettings
+
+import (
+	"strconv"
+
+	"github.com/git-logs/client/webserver/state"
+)
+
+// QuotaUsage is the current usage of a guild, to be checked against its quotas
+type QuotaUsage struct {
+	DeliveriesLastHour int
+	Webhooks           int
+	Repos              int
+}
+
+func GetQuotaUsage(guildId string) (*QuotaUsage, error) {
+	var u QuotaUsage
+
+	err := state.Pool.QueryRow(
+		state.Context,
+		"SELECT (SELECT COUNT(*) FROM "+state.TableWebhookLogs+" WHERE guild_id = $1 AND created_at > NOW() - INTERVAL '1 hour'), (SELECT COUNT(*) FROM "+state.TableWebhooks+" WHERE guild_id = $1), (SELECT COUNT(*) FROM "+state.TableRepos+" WHERE guild_id = $1)",
+		guildId,
+	).Scan(&u.DeliveriesLastHour, &u.Webhooks, &u.Repos)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// formatQuota formats usage against a quota, a nil quota is unlimited
+func formatQuota(used int, quota *int) string {
+	if quota == nil {
+		return strconv.Itoa(used) + "/unlimited"
+	}
+
+	return strconv.Itoa(used) + "/" + strconv.Itoa(*quota)
+}
+
+// DeliveryQuotaExceeded returns the reason a delivery is over the guilds delivery quota, or an
+// empty string if it is within it. The webhook and repository quotas are enforced by the bot
+// when they are created
+func (s *GuildSettings) DeliveryQuotaExceeded(u *QuotaUsage) string {
+	if s.MaxDeliveriesPerHour != nil && u.DeliveriesLastHour >= *s.MaxDeliveriesPerHour {
+		return "This guild has reached its quota of " + strconv.Itoa(*s.MaxDeliveriesPerHour) + " deliveries per hour"
+	}
+
+	return ""
+}
+
+// QuotaReport returns a human readable report of the usage against the guilds quotas
+func (s *GuildSettings) QuotaReport(u *QuotaUsage) string {
+	return "Deliveries (last hour): " + formatQuota(u.DeliveriesLastHour, s.MaxDeliveriesPerHour) + "\n" +
+		"Webhooks: " + formatQuota(u.Webhooks, s.MaxWebhooks) + "\n" +
+		"Repositories: " + formatQuota(u.Repos, s.MaxRepos) + "\n"
+}
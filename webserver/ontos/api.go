@@ -4,9 +4,12 @@ package ontos
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/git-logs/client/webserver/logos/events"
+	"github.com/git-logs/client/webserver/logos/guildsettings"
+	"github.com/git-logs/client/webserver/pneuma"
 	"github.com/git-logs/client/webserver/state"
 )
 
@@ -19,6 +22,8 @@ func init() {
 	for event := range events.SupportedEvents {
 		eventList = append(eventList, event)
 	}
+
+	sort.Strings(eventList)
 }
 
 // This endpoint can only be used if the discordgo websocket is open
@@ -41,6 +46,11 @@ func ApiStats(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(fmt.Sprintf("%d,%d,%d", guildCount, userCount, shardCount)))
 }
 
+// ApiMetrics returns the event processing counters of this webserver
+func ApiMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(pneuma.Metrics()))
+}
+
 func ApiEventsListView(w http.ResponseWriter, r *http.Request) {
 	events := []string{}
 
@@ -54,3 +64,47 @@ func ApiEventsListView(w http.ResponseWriter, r *http.Request) {
 func ApiEventsCommaSepView(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(strings.Join(eventList, ",")))
 }
+
+// getQuotaReport returns the quota report of the guild owning a webhook
+func getQuotaReport(webhookId string) (string, error) {
+	var guildId string
+	err := state.Pool.QueryRow(state.Context, "SELECT guild_id FROM "+state.TableWebhooks+" WHERE id = $1", webhookId).Scan(&guildId)
+
+	if err != nil {
+		return "", err
+	}
+
+	settings, err := guildsettings.GetGuildSettings(guildId)
+
+	if err != nil {
+		return "", err
+	}
+
+	usage, err := guildsettings.GetQuotaUsage(guildId)
+
+	if err != nil {
+		return "", err
+	}
+
+	return settings.QuotaReport(usage), nil
+}
+
+func ApiQuotas(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+
+	if id == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("This request is missing the id parameter"))
+		return
+	}
+
+	report, err := getQuotaReport(id)
+
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("Could not fetch quotas: " + err.Error()))
+		return
+	}
+
+	w.Write([]byte(report))
+}
@@ -15,10 +15,12 @@ import (
 
 	"github.com/git-logs/client/webserver/logos/eventmodifiers"
 	"github.com/git-logs/client/webserver/logos/events"
+	"github.com/git-logs/client/webserver/logos/guildsettings"
 	"github.com/git-logs/client/webserver/pneuma"
 	"github.com/git-logs/client/webserver/state"
 
 	"github.com/infinitybotlist/eureka/crypto"
+	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
 )
 
@@ -42,7 +44,10 @@ func GetWebhookRoute(w http.ResponseWriter, r *http.Request) {
 
 	var comment string
 	var broken bool
-	err := state.Pool.QueryRow(state.Context, "SELECT comment, broken FROM "+state.TableWebhooks+" WHERE id = $1", id).Scan(&comment, &broken)
+	var brokenReason pgtype.Text
+	var consecutiveFailures int
+	var defaultChannel pgtype.Text
+	err := state.Pool.QueryRow(state.Context, "SELECT comment, broken, broken_reason, (SELECT COALESCE(MAX(consecutive_failures), 0) FROM "+state.TableWebhookFailures+" WHERE webhook_id = $1), default_channel FROM "+state.TableWebhooks+" WHERE id = $1", id).Scan(&comment, &broken, &brokenReason, &consecutiveFailures, &defaultChannel)
 
 	if err != nil {
 		w.WriteHeader(404)
@@ -53,6 +58,9 @@ func GetWebhookRoute(w http.ResponseWriter, r *http.Request) {
 	var respStr = strings.Builder{}
 
 	respStr.WriteString("Broken: " + formatBool(broken) + "\n")
+	respStr.WriteString("Broken Reason: " + brokenReason.String + "\n")
+	respStr.WriteString("Consecutive Failures: " + strconv.Itoa(consecutiveFailures) + "\n")
+	respStr.WriteString("Default Channel: " + defaultChannel.String + "\n")
 	respStr.WriteString("Comment: " + comment + "\n\n")
 
 	// Get all event modifiers on this webhook
@@ -96,6 +104,45 @@ func GetWebhookRoute(w http.ResponseWriter, r *http.Request) {
 		respStr.WriteString("\n\n")
 	}
 
+	digests, err := state.Pool.Query(state.Context, "SELECT id, channel_id, events, interval_minutes, max_events FROM "+state.TableDigests+" WHERE webhook_id = $1", id)
+
+	if err == nil {
+		respStr.WriteString("Digests:\n\n")
+
+		for digests.Next() {
+			var digestID string
+			var channelID string
+			var digestEvents []string
+			var intervalMinutes int
+			var maxEvents int
+
+			err = digests.Scan(&digestID, &channelID, &digestEvents, &intervalMinutes, &maxEvents)
+
+			if err != nil {
+				respStr.WriteString("Error: " + err.Error() + " in fetching a digest \n")
+				continue
+			}
+
+			respStr.WriteString("ID: " + digestID + "\n")
+			respStr.WriteString("Channel ID: " + channelID + "\n")
+			respStr.WriteString("Events: " + strings.Join(digestEvents, ",") + "\n")
+			respStr.WriteString("Interval Minutes: " + strconv.Itoa(intervalMinutes) + "\n")
+			respStr.WriteString("Max Events: " + strconv.Itoa(maxEvents) + "\n\n")
+		}
+
+		digests.Close()
+
+		respStr.WriteString("\n")
+	}
+
+	quotas, err := getQuotaReport(id)
+
+	if err != nil {
+		respStr.WriteString("ERROR: " + err.Error() + " in fetching quotas for webhook\n\n")
+	} else {
+		respStr.WriteString("Quotas:\n\n" + quotas + "\n\n")
+	}
+
 	repos, err := state.Pool.Query(state.Context, "SELECT id, repo_name, channel_id, created_at FROM "+state.TableRepos+" WHERE webhook_id = $1", id)
 
 	if err == nil {
@@ -148,20 +195,7 @@ func HandleWebhookRoute(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if broken {
-		w.WriteHeader(500)
-		w.Write([]byte("This webhook is marked as broken!"))	
-	}
-
-	var guildId string
-	err = state.Pool.QueryRow(state.Context, "SELECT guild_id FROM "+state.TableWebhooks+" WHERE id = $1", id).Scan(&guildId)
-
-	if err != nil {
-		w.WriteHeader(404)
-		w.Write([]byte("This request has an invalid id parameter"))
-		return
-	}
-
+	// Verify the signature before anything about the webhook or its guild is revealed
 	var bodyBytes []byte
 
 	defer r.Body.Close()
@@ -181,12 +215,58 @@ func HandleWebhookRoute(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if broken {
+		w.WriteHeader(500)
+		w.Write([]byte("This webhook is marked as broken!"))
+		return
+	}
+
+	var guildId string
+	err = state.Pool.QueryRow(state.Context, "SELECT guild_id FROM "+state.TableWebhooks+" WHERE id = $1", id).Scan(&guildId)
+
+	if err != nil {
+		w.WriteHeader(404)
+		w.Write([]byte("This request has an invalid id parameter"))
+		return
+	}
+
+	settings, err := guildsettings.GetGuildSettings(guildId)
+
+	if err != nil {
+		state.Logger.Error("Could not fetch guild settings", zap.Error(err), zap.String("guildID", guildId), zap.String("webhookID", id))
+		w.WriteHeader(500)
+		w.Write([]byte("Could not fetch guild settings: " + err.Error()))
+		return
+	}
+
+	if settings.Banned {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("The guild this webhook belongs to has been banned from Git Logs!"))
+		return
+	}
+
 	if r.Header.Get("X-GitHub-Event") == "ping" {
 		w.WriteHeader(200)
 		w.Write([]byte("pong"))
 		return
 	}
 
+	usage, err := guildsettings.GetQuotaUsage(guildId)
+
+	if err != nil {
+		state.Logger.Error("Could not fetch quota usage", zap.Error(err), zap.String("guildID", guildId), zap.String("webhookID", id))
+		w.WriteHeader(500)
+		w.Write([]byte("Could not fetch quota usage: " + err.Error()))
+		return
+	}
+
+	if reason := settings.DeliveryQuotaExceeded(usage); reason != "" {
+		state.Logger.Warn("Guild quota exceeded, rejecting delivery", zap.String("guildID", guildId), zap.String("webhookID", id), zap.String("reason", reason))
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(reason))
+		return
+	}
+
 	var rw events.RepoWrapper
 
 	err = json.Unmarshal(bodyBytes, &rw)
@@ -200,23 +280,43 @@ func HandleWebhookRoute(w http.ResponseWriter, r *http.Request) {
 
 	var header = r.Header.Get("X-GitHub-Event")
 
-	// Get repo_name from database
+	// Get repo_name from database, organization-level events are matched on the organization login
 	var repoName string
 	var repoID string
-	err = state.Pool.QueryRow(state.Context, "SELECT id, repo_name FROM "+state.TableRepos+" WHERE repo_name = $1 AND webhook_id = $2", strings.ToLower(rw.Repo.FullName), id).Scan(&repoID, &repoName)
+	err = state.Pool.QueryRow(state.Context, "SELECT id, repo_name FROM "+state.TableRepos+" WHERE repo_name = $1 AND webhook_id = $2", strings.ToLower(rw.RouteName()), id).Scan(&repoID, &repoName)
 
 	if err != nil {
-		state.Logger.Warn("This repository is not configured on git-logs, ignoring", zap.Error(err), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", id))
-		w.WriteHeader(http.StatusPartialContent)
-		w.Write([]byte("This repository is not configured on git-logs, ignoring"))
-		return
+		if rw.Repo.FullName != "" {
+			state.Logger.Warn("This repository is not configured on git-logs, ignoring", zap.Error(err), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", id))
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write([]byte("This repository is not configured on git-logs, ignoring"))
+			return
+		}
+
+		// Events without a repository, such as GitHub App and organization events, go to the default channel of the webhook
+		defaultChannel, err := pneuma.DefaultChannel(id)
+
+		if err != nil {
+			state.Logger.Error("Could not fetch default channel", zap.Error(err), zap.String("webhookID", id))
+			w.WriteHeader(500)
+			w.Write([]byte("Could not fetch default channel: " + err.Error()))
+			return
+		}
+
+		if defaultChannel == "" {
+			state.Logger.Warn("Event has no repository and the webhook has no default channel, ignoring", zap.String("event", header), zap.String("orgName", rw.Org.Login), zap.String("webhookID", id))
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write([]byte("This event has no repository, its organization is not configured on git-logs and the webhook has no default channel set, ignoring"))
+			return
+		}
+
+		repoID = ""
 	}
 
+	w.WriteHeader(http.StatusAccepted)
 	w.Write([]byte(
 		"View logs at: " + state.Config.APIUrl + "/audit?log_id=" + logId + "\n",
 	))
-
-	w.WriteHeader(http.StatusAccepted)
 	w.Write([]byte("Going to process webhook event now: " + header))
 
 	go pneuma.HandleEvents(
@@ -240,6 +340,8 @@ You may also be looking for:
 - API (possibly unstable): api/
   - Counts: counts/
     - <server_count>,<user_count>,<shard_count>
+  - Quotas: quotas?id=ID
+    - Usage of the quotas of the guild owning the webhook
 
 - Webhooks: kittycat?id=ID
   - Get Webhook Info: GET kittycat?id=ID
@@ -0,0 +1,245 @@
+package pneuma
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/git-logs/client/webserver/logos/events"
+	"github.com/git-logs/client/webserver/logos/guildsettings"
+	"github.com/git-logs/client/webserver/state"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// Number of deliveries that must fail in a row before a webhook is marked as broken
+const brokenThreshold = 10
+
+// How often broken webhooks are checked for recovery
+const recoveryInterval = 10 * time.Minute
+
+// isDeliveryFailure returns whether a send error means that the destination itself is broken,
+// as opposed to a temporary error such as a network failure
+func isDeliveryFailure(err error) bool {
+	var restErr *discordgo.RESTError
+
+	if !errors.As(err, &restErr) || restErr.Message == nil {
+		return false
+	}
+
+	switch restErr.Message.Code {
+	case discordgo.ErrCodeUnknownChannel, discordgo.ErrCodeMissingAccess, discordgo.ErrCodeMissingPermissions:
+		return true
+	}
+
+	return false
+}
+
+// notifyFallbackChannel sends a notice to the guilds fallback channel, if one is set
+func notifyFallbackChannel(guildId, content string) {
+	settings, err := guildsettings.GetGuildSettings(guildId)
+
+	if err != nil {
+		state.Logger.Error("Could not fetch guild settings for notice", zap.Error(err), zap.String("guildID", guildId))
+		return
+	}
+
+	if settings.FallbackChannel == "" {
+		return
+	}
+
+	_, err = state.Discord.ChannelMessageSendComplex(settings.FallbackChannel, &discordgo.MessageSend{
+		Content:         content,
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	})
+
+	if err != nil {
+		state.Logger.Error("Could not send notice to fallback channel", zap.Error(err), zap.String("guildID", guildId), zap.String("channelID", settings.FallbackChannel))
+	}
+}
+
+// Causes a webhook can be marked as broken for, recovery re-checks only the cause the webhook broke for
+const (
+	// Deliveries to a channel failed, re-checked with checkChannel
+	brokenCauseChannel = "channel"
+	// The payload of an event could not be rendered, re-checked by rendering the payload again
+	brokenCauseRender = "render"
+)
+
+// deliveryFailure is a failed delivery of an event to a channel
+type deliveryFailure struct {
+	ChannelID string
+	Cause     string
+	Reason    string
+
+	// Event and Payload are only set for brokenCauseRender, to render the payload again on recovery
+	Event   string
+	Payload []byte
+}
+
+// recordDeliveryFailure counts a failed delivery to a channel of a webhook, marking the webhook
+// as broken once brokenThreshold deliveries to the same channel have failed in a row
+func recordDeliveryFailure(logId, webhookId, guildId string, f deliveryFailure) {
+	var failures int
+
+	err := state.Pool.QueryRow(state.Context, "INSERT INTO "+state.TableWebhookFailures+" (webhook_id, channel_id, consecutive_failures) VALUES ($1, $2, 1) ON CONFLICT (webhook_id, channel_id) DO UPDATE SET consecutive_failures = "+state.TableWebhookFailures+".consecutive_failures + 1 RETURNING consecutive_failures", webhookId, f.ChannelID).Scan(&failures)
+
+	if err != nil {
+		state.Logger.Error("Could not record delivery failure", zap.Error(err), zap.String("webhookID", webhookId), zap.String("channelID", f.ChannelID), zap.String("logId", logId))
+		return
+	}
+
+	if failures < brokenThreshold {
+		return
+	}
+
+	var payload pgtype.Text
+	if f.Cause == brokenCauseRender {
+		payload = pgtype.Text{String: string(f.Payload), Valid: true}
+	}
+
+	// Only the failure that breaks the webhook sets the cause, so that it is not overwritten by later failures
+	res, err := state.Pool.Exec(state.Context, "UPDATE "+state.TableWebhooks+" SET broken = true, broken_cause = $2, broken_channel = $3, broken_reason = $4, broken_event = $5, broken_payload = $6 WHERE id = $1 AND broken = false", webhookId, f.Cause, f.ChannelID, f.Reason, f.Event, payload)
+
+	if err != nil {
+		state.Logger.Error("Could not mark webhook as broken", zap.Error(err), zap.String("webhookID", webhookId), zap.String("logId", logId))
+		return
+	}
+
+	if res.RowsAffected() == 0 {
+		return
+	}
+
+	updateLogEntries(logId, webhookId, guildId, "Webhook marked as broken: channelId="+f.ChannelID, "consecutiveFailures="+strconv.Itoa(failures), "cause="+f.Cause, "reason="+f.Reason)
+	state.Logger.Warn("Webhook marked as broken", zap.String("webhookID", webhookId), zap.String("guildID", guildId), zap.String("channelID", f.ChannelID), zap.Int("consecutiveFailures", failures), zap.String("cause", f.Cause), zap.String("reason", f.Reason))
+
+	notifyFallbackChannel(guildId, "Webhook ``"+webhookId+"`` has been marked as broken after "+strconv.Itoa(failures)+" failed deliveries in a row to <#"+f.ChannelID+"> and will not accept events until it recovers.\n\n**Last error:** "+f.Reason+"\n\nView logs at: "+state.Config.APIUrl+"/audit?log_id="+logId)
+}
+
+// recordDeliverySuccess resets the consecutive failures of a channel of a webhook
+func recordDeliverySuccess(webhookId, channelId string) {
+	_, err := state.Pool.Exec(state.Context, "DELETE FROM "+state.TableWebhookFailures+" WHERE webhook_id = $1 AND channel_id = $2", webhookId, channelId)
+
+	if err != nil {
+		state.Logger.Error("Could not reset delivery failures", zap.Error(err), zap.String("webhookID", webhookId), zap.String("channelID", channelId))
+	}
+}
+
+// recheckBrokenCause returns nil once the cause a webhook was marked as broken for is resolved
+func recheckBrokenCause(cause, channelId, event string, payload []byte) error {
+	switch cause {
+	case brokenCauseChannel:
+		return checkChannel(channelId)
+	case brokenCauseRender:
+		evtFn, ok := events.SupportedEvents[event]
+
+		if !ok {
+			// The renderer was removed, the event is now sent with the generic embed
+			return nil
+		}
+
+		_, err := renderEvent(evtFn, payload, events.Printer(""))
+
+		// A panicking renderer falls back to the generic embed, so only errors keep the webhook broken
+		var rp *renderPanic
+		if errors.As(err, &rp) {
+			return nil
+		}
+
+		return err
+	}
+
+	return errors.New("webhook was not marked as broken by a delivery failure")
+}
+
+// recoverBrokenWebhooks clears the broken flag of all broken webhooks whose cause of breaking
+// has been resolved. Webhooks marked as broken by hand are left alone
+func recoverBrokenWebhooks() error {
+	rows, err := state.Pool.Query(state.Context, "SELECT id, guild_id, broken_cause, broken_channel, broken_event, broken_payload FROM "+state.TableWebhooks+" WHERE broken = true AND broken_cause IS NOT NULL")
+
+	if err != nil {
+		return err
+	}
+
+	type brokenWebhook struct {
+		id, guildId, cause string
+		channelId, event   pgtype.Text
+		payload            pgtype.Text
+	}
+
+	var webhooks []brokenWebhook
+
+	for rows.Next() {
+		var webhook brokenWebhook
+
+		err = rows.Scan(&webhook.id, &webhook.guildId, &webhook.cause, &webhook.channelId, &webhook.event, &webhook.payload)
+
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		if err := recheckBrokenCause(webhook.cause, webhook.channelId.String, webhook.event.String, []byte(webhook.payload.String)); err != nil {
+			state.Logger.Debug("Broken webhook has not recovered yet", zap.Error(err), zap.String("webhookID", webhook.id), zap.String("cause", webhook.cause), zap.String("channelID", webhook.channelId.String))
+			continue
+		}
+
+		tx, err := state.Pool.Begin(state.Context)
+
+		if err != nil {
+			state.Logger.Error("Could not start transaction", zap.Error(err), zap.String("webhookID", webhook.id))
+			continue
+		}
+
+		_, err = tx.Exec(state.Context, "UPDATE "+state.TableWebhooks+" SET broken = false, broken_cause = NULL, broken_channel = NULL, broken_reason = NULL, broken_event = NULL, broken_payload = NULL WHERE id = $1", webhook.id)
+
+		if err == nil {
+			_, err = tx.Exec(state.Context, "DELETE FROM "+state.TableWebhookFailures+" WHERE webhook_id = $1", webhook.id)
+		}
+
+		if err == nil {
+			err = tx.Commit(state.Context)
+		}
+
+		if err != nil {
+			tx.Rollback(state.Context)
+			state.Logger.Error("Could not clear broken flag", zap.Error(err), zap.String("webhookID", webhook.id))
+			continue
+		}
+
+		state.Logger.Info("Broken webhook recovered", zap.String("webhookID", webhook.id), zap.String("guildID", webhook.guildId), zap.String("cause", webhook.cause))
+
+		notifyFallbackChannel(webhook.guildId, "Webhook ``"+webhook.id+"`` has recovered and is accepting events again.")
+	}
+
+	return nil
+}
+
+// RecoveryTask periodically checks if broken webhooks have recovered
+func RecoveryTask() {
+	for {
+		func() {
+			defer recoverPanic("Panic while checking broken webhooks")
+
+			err := recoverBrokenWebhooks()
+
+			if err != nil {
+				state.Logger.Error("Could not check broken webhooks", zap.Error(err))
+			}
+		}()
+
+		time.Sleep(recoveryInterval)
+	}
+}
@@ -0,0 +1,429 @@
+package pneuma
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/git-logs/client/webserver/logos/eventmodifiers"
+	"github.com/git-logs/client/webserver/logos/events"
+	"github.com/git-logs/client/webserver/logos/guildsettings"
+	"github.com/git-logs/client/webserver/state"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+	"golang.org/x/text/message"
+)
+
+// The singular and plural phrases used to count events in a digest summary, events not in
+// this list use their event name instead. Both are format strings in the message catalog
+var digestNouns = map[string][2]string{
+	"push":                        {"1 push", "%d pushes"},
+	"pull_request":                {"1 PR", "%d PRs"},
+	"pull_request_review_comment": {"1 review comment", "%d review comments"},
+	"issues":                      {"1 issue", "%d issues"},
+	"issue_comment":               {"1 comment", "%d comments"},
+	"commit_comment":              {"1 commit comment", "%d commit comments"},
+	"workflow_run":                {"1 workflow run", "%d workflow runs"},
+	"workflow_job":                {"1 workflow job", "%d workflow jobs"},
+	"check_run":                   {"1 check run", "%d check runs"},
+	"check_suite":                 {"1 check suite", "%d check suites"},
+	"star":                        {"1 star", "%d stars"},
+	"watch":                       {"1 watcher", "%d watchers"},
+	"fork":                        {"1 fork", "%d forks"},
+	"release":                     {"1 release", "%d releases"},
+	"create":                      {"1 ref created", "%d refs created"},
+	"delete":                      {"1 ref deleted", "%d refs deleted"},
+}
+
+// The wording of actions and conclusions in a digest summary, an empty string means that
+// the action is not shown at all. Other actions are shown as translated by the catalog
+var digestVerbs = map[string]string{
+	"created":   "",
+	"started":   "",
+	"failure":   "failed",
+	"success":   "succeeded",
+	"timed_out": "timed out",
+}
+
+type digest struct {
+	ID              string
+	GuildID         string
+	WebhookID       string
+	ChannelID       string
+	Events          []string
+	IntervalMinutes int
+	MaxEvents       int
+}
+
+type digestEntry struct {
+	ID    int64
+	Kind  string
+	Title string
+	URL   string
+}
+
+// The fields of a payload needed to work out what an event counts as in a digest
+type digestPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Merged bool `json:"merged"`
+	} `json:"pull_request"`
+	WorkflowRun struct {
+		Conclusion string `json:"conclusion"`
+	} `json:"workflow_run"`
+	WorkflowJob struct {
+		Conclusion string `json:"conclusion"`
+	} `json:"workflow_job"`
+	CheckRun struct {
+		Conclusion string `json:"conclusion"`
+	} `json:"check_run"`
+	CheckSuite struct {
+		Conclusion string `json:"conclusion"`
+	} `json:"check_suite"`
+}
+
+// getDigest returns the digest buffering ghEvent for a destination channel, or nil if
+// the event should be sent directly
+func getDigest(webhookId, channelId, ghEvent string) (*digest, error) {
+	rows, err := state.Pool.Query(state.Context, "SELECT id, guild_id, events, interval_minutes, max_events FROM "+state.TableDigests+" WHERE webhook_id = $1 AND channel_id = $2", webhookId, channelId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var d = digest{
+			WebhookID: webhookId,
+			ChannelID: channelId,
+		}
+
+		err = rows.Scan(&d.ID, &d.GuildID, &d.Events, &d.IntervalMinutes, &d.MaxEvents)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range d.Events {
+			if eventmodifiers.IsMatch(event, ghEvent) {
+				return &d, nil
+			}
+		}
+	}
+
+	return nil, rows.Err()
+}
+
+// digestKind returns what an event is counted as in a digest summary, for example
+// "pull_request opened" or "workflow_run failure"
+func digestKind(header string, bodyBytes []byte) string {
+	var p digestPayload
+
+	if err := json.Unmarshal(bodyBytes, &p); err != nil {
+		return header
+	}
+
+	if header == "pull_request" && p.Action == "closed" && p.PullRequest.Merged {
+		return header + " merged"
+	}
+
+	for _, conclusion := range []string{p.WorkflowRun.Conclusion, p.WorkflowJob.Conclusion, p.CheckRun.Conclusion, p.CheckSuite.Conclusion} {
+		if conclusion != "" {
+			return header + " " + conclusion
+		}
+	}
+
+	if p.Action != "" {
+		return header + " " + p.Action
+	}
+
+	return header
+}
+
+// describeKind turns a digest kind and its count into a human readable phrase such as "3 PRs opened"
+func describeKind(p *message.Printer, kind string, count int) string {
+	event, action, _ := strings.Cut(kind, " ")
+
+	var phrase string
+	if noun, ok := digestNouns[event]; !ok {
+		name := strings.ReplaceAll(event, "_", " ")
+
+		if count == 1 {
+			phrase = p.Sprintf("1 %s event", name)
+		} else {
+			phrase = p.Sprintf("%d %s events", count, name)
+		}
+	} else if count == 1 {
+		phrase = p.Sprintf(noun[0])
+	} else {
+		phrase = p.Sprintf(noun[1], count)
+	}
+
+	verb, ok := digestVerbs[action]
+
+	if !ok {
+		verb = events.TranslateAction(p, action)
+	} else if verb != "" {
+		verb = p.Sprintf(verb)
+	}
+
+	if verb != "" {
+		phrase += " " + verb
+	}
+
+	return phrase
+}
+
+// newDigestEntry creates a digest entry for an event using the first embed of its rendered message
+func newDigestEntry(header, kind string, messageSend *discordgo.MessageSend) digestEntry {
+	var entry = digestEntry{
+		Kind:  kind,
+		Title: header,
+	}
+
+	if len(messageSend.Embeds) > 0 {
+		if messageSend.Embeds[0].Title != "" {
+			entry.Title = messageSend.Embeds[0].Title
+		}
+
+		entry.URL = messageSend.Embeds[0].URL
+	}
+
+	return entry
+}
+
+// digestMessage creates a summary embed for a set of digest entries, in the language of p
+func digestMessage(p *message.Printer, title string, entries []digestEntry) *discordgo.MessageSend {
+	var kinds []string
+	var counts = map[string]int{}
+
+	for _, entry := range entries {
+		if counts[entry.Kind] == 0 {
+			kinds = append(kinds, entry.Kind)
+		}
+
+		counts[entry.Kind]++
+	}
+
+	var summary []string
+
+	for _, kind := range kinds {
+		summary = append(summary, describeKind(p, kind, counts[kind]))
+	}
+
+	desc := strings.Join(summary, ", ") + "\n"
+
+	for i, entry := range entries {
+		line := "\n- " + entry.Title
+
+		if entry.URL != "" {
+			line = "\n- [" + entry.Title + "](" + entry.URL + ")"
+		}
+
+		// Leave some room for the summary line at the end
+		if len(desc)+len(line) > events.EMBED_DESCRIPTION_LIMIT-100 {
+			desc += "\n" + p.Sprintf("...and %d more", len(entries)-i)
+			break
+		}
+
+		desc += line
+	}
+
+	return &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Color:       events.DefaultPalette.Info,
+				Title:       title,
+				Description: desc,
+				Timestamp:   time.Now().Format(time.RFC3339),
+			},
+		},
+		// Digests never ping anyone
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	}
+}
+
+// bufferDigestEntry adds an event to a digest, flushing the digest if it is full
+//
+// The caller must hold the webhook lock from state.MapMutex
+func bufferDigestEntry(d *digest, header string, entry digestEntry) error {
+	_, err := state.Pool.Exec(state.Context, "INSERT INTO "+state.TableDigestEntries+" (digest_id, event, kind, title, url) VALUES ($1, $2, $3, $4, $5)", d.ID, header, entry.Kind, entry.Title, entry.URL)
+
+	if err != nil {
+		return err
+	}
+
+	var count int
+
+	err = state.Pool.QueryRow(state.Context, "SELECT COUNT(*) FROM "+state.TableDigestEntries+" WHERE digest_id = $1", d.ID).Scan(&count)
+
+	if err != nil {
+		return err
+	}
+
+	if count >= d.MaxEvents {
+		return flushDigest(d)
+	}
+
+	return nil
+}
+
+// flushDigest posts all buffered entries of a digest as one summary embed. The entries are locked
+// and deleted in a transaction that is only committed once the summary has been sent, so a failed
+// send keeps them for the next flush and a sent summary is never posted twice
+//
+// The caller must hold the webhook lock from state.MapMutex
+func flushDigest(d *digest) error {
+	tx, err := state.Pool.Begin(state.Context)
+
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback(state.Context)
+
+	rows, err := tx.Query(state.Context, "SELECT id, kind, title, url FROM "+state.TableDigestEntries+" WHERE digest_id = $1 ORDER BY id ASC FOR UPDATE", d.ID)
+
+	if err != nil {
+		return err
+	}
+
+	var entries []digestEntry
+
+	for rows.Next() {
+		var entry digestEntry
+
+		err = rows.Scan(&entry.ID, &entry.Kind, &entry.Title, &entry.URL)
+
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	_, err = tx.Exec(state.Context, "DELETE FROM "+state.TableDigestEntries+" WHERE digest_id = $1 AND id <= $2", d.ID, entries[len(entries)-1].ID)
+
+	if err != nil {
+		return err
+	}
+
+	// Digests still flush in English with the default colors if the guild settings cannot be fetched
+	settings, settingsErr := guildsettings.GetGuildSettings(d.GuildID)
+
+	var p = events.Printer("")
+	if settingsErr == nil {
+		p = events.Printer(settings.Locale)
+	}
+
+	messageSend := digestMessage(p, p.Sprintf("Digest of %d events", len(entries)), entries)
+
+	if settingsErr == nil {
+		events.ApplyPalette(messageSend, guildPalette(settings))
+	}
+
+	for i, embed := range messageSend.Embeds {
+		messageSend.Embeds[i] = applyEmbedLimits(embed)
+	}
+
+	_, err = state.Discord.ChannelMessageSendComplex(d.ChannelID, messageSend)
+
+	if err != nil {
+		// Put the entries back so that the next flush retries them
+		if rbErr := tx.Rollback(state.Context); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+
+		return err
+	}
+
+	if err = tx.Commit(state.Context); err != nil {
+		// The summary has already been posted, so remove the entries outside of the failed
+		// transaction instead of posting them again on the next flush
+		_, delErr := state.Pool.Exec(state.Context, "DELETE FROM "+state.TableDigestEntries+" WHERE digest_id = $1 AND id <= $2", d.ID, entries[len(entries)-1].ID)
+
+		if delErr != nil {
+			return errors.Join(err, delErr)
+		}
+
+		state.Logger.Warn("Digest sent but its transaction failed to commit, removed the sent entries directly", zap.Error(err), zap.String("digestID", d.ID))
+	}
+
+	return nil
+}
+
+// flushDueDigests flushes all digests whose oldest buffered entry is older than the
+// digests interval
+func flushDueDigests() error {
+	rows, err := state.Pool.Query(state.Context, "SELECT id, guild_id, webhook_id, channel_id, events, interval_minutes, max_events FROM "+state.TableDigests+" d WHERE EXISTS (SELECT 1 FROM "+state.TableDigestEntries+" e WHERE e.digest_id = d.id AND e.created_at <= NOW() - make_interval(mins => d.interval_minutes))")
+
+	if err != nil {
+		return err
+	}
+
+	var digests []*digest
+
+	for rows.Next() {
+		var d digest
+
+		err = rows.Scan(&d.ID, &d.GuildID, &d.WebhookID, &d.ChannelID, &d.Events, &d.IntervalMinutes, &d.MaxEvents)
+
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		digests = append(digests, &d)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, d := range digests {
+		// Flush in order with the rest of the webhooks events
+		l := state.MapMutex.Lock(d.WebhookID)
+		err = flushDigest(d)
+		l.Unlock()
+
+		if err != nil {
+			state.Logger.Error("Could not flush digest", zap.Error(err), zap.String("digestID", d.ID), zap.String("webhookID", d.WebhookID), zap.String("channelID", d.ChannelID))
+		}
+	}
+
+	return nil
+}
+
+// DigestTask periodically flushes digests that are due. As buffered entries are stored in
+// the database, this also flushes digests left over from before a restart
+func DigestTask() {
+	for {
+		func() {
+			defer recoverPanic("Panic while flushing digests")
+
+			err := flushDueDigests()
+
+			if err != nil {
+				state.Logger.Error("Could not fetch due digests", zap.Error(err))
+			}
+		}()
+
+		time.Sleep(time.Minute)
+	}
+}
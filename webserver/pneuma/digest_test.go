@@ -0,0 +1,29 @@
+package pneuma
+
+import (
+	"testing"
+
+	"github.com/git-logs/client/webserver/logos/events"
+)
+
+func TestDescribeKind(t *testing.T) {
+	for _, tt := range []struct {
+		locale string
+		kind   string
+		count  int
+		want   string
+	}{
+		{"en", "pull_request opened", 3, "3 PRs opened"},
+		{"en", "push", 1, "1 push"},
+		{"en", "workflow_run failure", 2, "2 workflow runs failed"},
+		{"en", "star created", 1, "1 star"},
+		{"en", "deploy_key created", 2, "2 deploy key events"},
+		{"de", "pull_request opened", 3, "3 PRs geöffnet"},
+		{"de", "issue_comment", 1, "1 Kommentar"},
+		{"pt", "issue_comment", 2, "2 comentários"},
+	} {
+		if got := describeKind(events.Printer(tt.locale), tt.kind, tt.count); got != tt.want {
+			t.Errorf("%s %q x%d: got %q, want %q", tt.locale, tt.kind, tt.count, got, tt.want)
+		}
+	}
+}
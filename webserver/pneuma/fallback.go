@@ -0,0 +1,58 @@
+package pneuma
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/git-logs/client/webserver/state"
+	"go.uber.org/zap"
+	"golang.org/x/text/message"
+)
+
+// maxLoggedPayload is how much of a payload sent with the generic embed is kept in the audit log
+const maxLoggedPayload = 1024
+
+// renderPanic is returned by renderEvent when the renderer panicked
+type renderPanic struct {
+	Value any
+	Stack []byte
+}
+
+func (p *renderPanic) Error() string {
+	return fmt.Sprintf("renderer panicked: %v", p.Value)
+}
+
+// renderEvent calls a renderer, turning a panic into a *renderPanic error so that one bad
+// payload cannot take down the whole webserver
+func renderEvent(evtFn func(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error), bodyBytes []byte, p *message.Printer) (messageSend *discordgo.MessageSend, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			messageSend = nil
+			err = &renderPanic{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	return evtFn(bodyBytes, p)
+}
+
+// recoverPanic counts and logs a panic of a background goroutine the same way HandleEvents
+// does, so that one bad flush or check cannot take down the whole webserver. It has to be
+// deferred directly, as recover only works there
+func recoverPanic(msg string, fields ...zap.Field) {
+	if r := recover(); r != nil {
+		metricDeliveryPanics.Add(1)
+		state.Logger.Error(msg, append(fields, zap.Any("panic", r), zap.String("stack", string(debug.Stack())))...)
+	}
+}
+
+// truncatePayload returns the start of a payload for the audit log, noting its full size if it
+// was cut
+func truncatePayload(bodyBytes []byte) string {
+	if len(bodyBytes) <= maxLoggedPayload {
+		return string(bodyBytes)
+	}
+
+	return string(bodyBytes[:maxLoggedPayload]) + "... (" + strconv.Itoa(len(bodyBytes)) + " bytes)"
+}
@@ -0,0 +1,65 @@
+package pneuma
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/git-logs/client/webserver/logos/events"
+	"github.com/git-logs/client/webserver/state"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+	"golang.org/x/text/message"
+)
+
+func TestRenderEventRecoversPanic(t *testing.T) {
+	panicking := func(bytes []byte, p *message.Printer) (*discordgo.MessageSend, error) {
+		var ids []string
+		return &discordgo.MessageSend{Content: ids[0]}, nil
+	}
+
+	messageSend, err := renderEvent(panicking, []byte("{}"), events.Printer("en"))
+
+	if messageSend != nil {
+		t.Fatalf("expected no message after a panic, got %v", messageSend)
+	}
+
+	var rp *renderPanic
+	if !errors.As(err, &rp) {
+		t.Fatalf("expected a renderPanic error, got %v", err)
+	}
+
+	if !strings.Contains(string(rp.Stack), "TestRenderEventRecoversPanic") {
+		t.Fatalf("stack trace does not contain the panicking renderer:\n%s", rp.Stack)
+	}
+}
+
+func TestRecoverPanic(t *testing.T) {
+	state.Logger = zap.NewNop()
+
+	before := metricDeliveryPanics.Load()
+
+	func() {
+		defer recoverPanic("Panic in test")
+
+		var entries []digestEntry
+		_ = entries[0]
+	}()
+
+	if got := metricDeliveryPanics.Load() - before; got != 1 {
+		t.Fatalf("expected the panic to be counted once, counted %d", got)
+	}
+}
+
+func TestTruncatePayload(t *testing.T) {
+	if got := truncatePayload([]byte(`{"zen":"Keep it logically awesome."}`)); got != `{"zen":"Keep it logically awesome."}` {
+		t.Fatalf("short payloads should be kept as is, got %q", got)
+	}
+
+	payload := []byte(strings.Repeat("a", maxLoggedPayload+500))
+
+	if got, want := truncatePayload(payload), strings.Repeat("a", maxLoggedPayload)+"... (1524 bytes)"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,37 @@
+package pneuma
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// Counters describing how deliveries were processed since the webserver started
+var (
+	metricEventsProcessed atomic.Int64
+	metricRenderErrors    atomic.Int64
+	metricRenderPanics    atomic.Int64
+	metricRenderFallbacks atomic.Int64
+	metricDeliveryPanics  atomic.Int64
+)
+
+// Metrics returns the current value of every counter, one "name value" pair per line
+func Metrics() string {
+	var metrics = []struct {
+		name  string
+		value *atomic.Int64
+	}{
+		{"events_processed", &metricEventsProcessed},
+		{"render_errors", &metricRenderErrors},
+		{"render_panics", &metricRenderPanics},
+		{"render_fallbacks", &metricRenderFallbacks},
+		{"delivery_panics", &metricDeliveryPanics},
+	}
+
+	var lines []string
+	for _, m := range metrics {
+		lines = append(lines, fmt.Sprintf("%s %d", m.name, m.value.Load()))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -2,39 +2,21 @@
 package pneuma
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"runtime/debug"
 	"strings"
 
 	"github.com/git-logs/client/webserver/logos/eventmodifiers"
 	"github.com/git-logs/client/webserver/logos/events"
+	"github.com/git-logs/client/webserver/logos/guildsettings"
 	"github.com/git-logs/client/webserver/state"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
 )
 
-const (
-	// EMBED_TITLE_LIMIT is the maximum length of an embed title
-	EMBED_TITLE_LIMIT = 256
-	// EMBED_DESCRIPTION_LIMIT is the maximum length of an embed description
-	EMBED_DESCRIPTION_LIMIT = 4096
-	// EMBED_FIELDS_MAX_COUNT is the maximum number of fields in an embed
-	EMBED_FIELDS_MAX_COUNT = 25
-	// EMBED_FIELD_NAME_LIMIT is the maximum length of an embed field name
-	EMBED_FIELD_NAME_LIMIT = 256
-	// EMBED_FIELD_VALUE_LIMIT is the maximum length of an embed field value
-	EMBED_FIELD_VALUE_LIMIT = 1024
-	// EMBED_FOOTER_TEXT_LIMIT is the maximum length of an embed footer text
-	EMBED_FOOTER_TEXT_LIMIT = 2048
-	// EMBED_AUTHOR_NAME_LIMIT is the maximum length of an embed author name
-	EMBED_AUTHOR_NAME_LIMIT = 256
-	// EMBED_TOTAL_LIMIT is the maximum length of an embed
-	EMBED_TOTAL_LIMIT = 6000
-)
-
 func updateLogEntries(logId, webhookId, guildId string, entries ...any) error {
 	// Check for log_id in database
 	var count int
@@ -57,6 +39,43 @@ func updateLogEntries(logId, webhookId, guildId string, entries ...any) error {
 	return err
 }
 
+// DefaultChannel returns the channel that events without a repository are sent to on a
+// webhook, or an empty string if the webhook has none
+func DefaultChannel(webhookId string) (string, error) {
+	var defaultChannel pgtype.Text
+
+	err := state.Pool.QueryRow(state.Context, "SELECT default_channel FROM "+state.TableWebhooks+" WHERE id = $1", webhookId).Scan(&defaultChannel)
+
+	if err != nil {
+		return "", err
+	}
+
+	return defaultChannel.String, nil
+}
+
+// guildPalette returns the colors a guild picked, on top of the default palette
+func guildPalette(settings *guildsettings.GuildSettings) events.Palette {
+	var palette = events.DefaultPalette
+
+	for _, c := range []struct {
+		color    *int
+		override *int
+	}{
+		{&palette.Success, settings.ColorSuccess},
+		{&palette.Failure, settings.ColorFailure},
+		{&palette.Warning, settings.ColorWarning},
+		{&palette.Neutral, settings.ColorNeutral},
+		{&palette.Info, settings.ColorInfo},
+		{&palette.Severe, settings.ColorSevere},
+	} {
+		if c.override != nil {
+			*c.color = *c.override
+		}
+	}
+
+	return palette
+}
+
 func applyEmbedLimits(e *discordgo.MessageEmbed) *discordgo.MessageEmbed {
 	totalChars := 0
 
@@ -88,35 +107,35 @@ func applyEmbedLimits(e *discordgo.MessageEmbed) *discordgo.MessageEmbed {
 
 	if e.Title != "" {
 		// Slice title to EMBED_TITLE_LIMIT
-		e.Title = _sliceChars(e.Title, &totalChars, EMBED_TITLE_LIMIT, EMBED_TOTAL_LIMIT)
+		e.Title = _sliceChars(e.Title, &totalChars, events.EMBED_TITLE_LIMIT, events.EMBED_TOTAL_LIMIT)
 	}
 
 	if e.Description != "" {
 		// Slice description to EMBED_DESCRIPTION_LIMIT
-		e.Description = _sliceChars(e.Description, &totalChars, EMBED_DESCRIPTION_LIMIT, EMBED_TOTAL_LIMIT)
+		e.Description = _sliceChars(e.Description, &totalChars, events.EMBED_DESCRIPTION_LIMIT, events.EMBED_TOTAL_LIMIT)
 	}
 
 	// Slice out fields if there are too many
-	if len(e.Fields) > EMBED_FIELDS_MAX_COUNT {
-		e.Fields = e.Fields[:EMBED_FIELDS_MAX_COUNT]
+	if len(e.Fields) > events.EMBED_FIELDS_MAX_COUNT {
+		e.Fields = e.Fields[:events.EMBED_FIELDS_MAX_COUNT]
 	}
 
 	for i, f := range e.Fields {
 		// Slice field name to EMBED_FIELD_NAME_LIMIT
-		e.Fields[i].Name = _sliceChars(f.Name, &totalChars, EMBED_FIELD_NAME_LIMIT, EMBED_TOTAL_LIMIT)
+		e.Fields[i].Name = _sliceChars(f.Name, &totalChars, events.EMBED_FIELD_NAME_LIMIT, events.EMBED_TOTAL_LIMIT)
 
 		// Slice field value to EMBED_FIELD_VALUE_LIMIT
-		e.Fields[i].Value = _sliceChars(f.Value, &totalChars, EMBED_FIELD_VALUE_LIMIT, EMBED_TOTAL_LIMIT)
+		e.Fields[i].Value = _sliceChars(f.Value, &totalChars, events.EMBED_FIELD_VALUE_LIMIT, events.EMBED_TOTAL_LIMIT)
 	}
 
 	if e.Footer != nil {
 		// Slice footer text to EMBED_FOOTER_TEXT_LIMIT
-		e.Footer.Text = _sliceChars(e.Footer.Text, &totalChars, EMBED_FOOTER_TEXT_LIMIT, EMBED_TOTAL_LIMIT)
+		e.Footer.Text = _sliceChars(e.Footer.Text, &totalChars, events.EMBED_FOOTER_TEXT_LIMIT, events.EMBED_TOTAL_LIMIT)
 	}
 
 	if e.Author != nil {
 		// Slice author name to EMBED_AUTHOR_NAME_LIMIT
-		e.Author.Name = _sliceChars(e.Author.Name, &totalChars, EMBED_AUTHOR_NAME_LIMIT, EMBED_TOTAL_LIMIT)
+		e.Author.Name = _sliceChars(e.Author.Name, &totalChars, events.EMBED_AUTHOR_NAME_LIMIT, events.EMBED_TOTAL_LIMIT)
 	}
 
 	return e
@@ -131,14 +150,25 @@ func HandleEvents(
 	webhookId string,
 	guildId string,
 ) {
+	// HandleEvents runs in its own goroutine, so a panic here would otherwise crash the webserver
+	defer func() {
+		if r := recover(); r != nil {
+			metricDeliveryPanics.Add(1)
+			updateLogEntries(logId, webhookId, guildId, "Internal Error: panic while processing event:", fmt.Sprint(r), "\n"+string(debug.Stack()))
+			state.Logger.Error("Panic while processing event", zap.Any("panic", r), zap.String("stack", string(debug.Stack())), zap.String("webhookID", webhookId), zap.String("event", header), zap.String("logId", logId))
+		}
+	}()
+
 	// Ensure one at a time
 	l := state.MapMutex.Lock(webhookId)
 	defer l.Unlock()
 
-	updateLogEntries(logId, webhookId, guildId, "Processing event: "+header, "repoName="+rw.Repo.FullName, "webhookID="+webhookId, "event="+header, "logId="+logId)
+	metricEventsProcessed.Add(1)
+
+	updateLogEntries(logId, webhookId, guildId, "Processing event: "+header, "repoName="+rw.RouteName(), "webhookID="+webhookId, "event="+header, "logId="+logId)
 
 	// Check event modifiers
-	modres, err := eventmodifiers.CheckEventAllowed(webhookId, repoId, header)
+	modres, err := eventmodifiers.CheckEventAllowed(webhookId, repoId, header, bodyBytes)
 
 	if err != nil {
 		updateLogEntries(logId, webhookId, guildId, "Error checking event modifiers: "+err.Error())
@@ -160,16 +190,35 @@ func HandleEvents(
 
 	var channelIds []string
 
+	// Channels that get a one-line summary instead of embeds
+	var oneLineChannels = map[string]bool{}
+
 	// Channel override comes from the event modifier, in the case of an event modifier, we only send
 	// to the channel specified in the event modifier, not to all channels set
 	if modres.ChannelOverride != "" {
 		channelIds = []string{modres.ChannelOverride}
+	} else if repoId == "" {
+		// Events without a configured repository or organization go to the default channel of the webhook instead
+		defaultChannel, err := DefaultChannel(webhookId)
+
+		if err != nil {
+			updateLogEntries(logId, webhookId, guildId, "Default channel fetch error", "error="+err.Error())
+			state.Logger.Error("Default channel fetch error", zap.Error(err), zap.String("webhookID", webhookId), zap.String("logId", logId))
+			return
+		}
+
+		if defaultChannel == "" {
+			updateLogEntries(logId, webhookId, guildId, "Event has no repository and the webhook has no default channel, ignoring")
+			return
+		}
+
+		channelIds = []string{defaultChannel}
 	} else {
 		// Get channel ID from database
-		rows, err := state.Pool.Query(state.Context, "SELECT channel_id FROM "+state.TableRepos+" WHERE repo_name = $1 AND webhook_id = $2", strings.ToLower(rw.Repo.FullName), webhookId)
+		rows, err := state.Pool.Query(state.Context, "SELECT channel_id, one_line FROM "+state.TableRepos+" WHERE repo_name = $1 AND webhook_id = $2", strings.ToLower(rw.RouteName()), webhookId)
 
 		if err != nil {
-			updateLogEntries(logId, "Channel id fetch error: acl="+modres.ACLFail, "error="+err.Error())
+			updateLogEntries(logId, webhookId, guildId, "Channel id fetch error: acl="+modres.ACLFail, "error="+err.Error())
 			state.Logger.Error("Channel id fetch error", zap.Error(err), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", webhookId), zap.String("logId", logId))
 			return
 		}
@@ -178,80 +227,232 @@ func HandleEvents(
 
 		for rows.Next() {
 			var channelId string
+			var oneLine bool
 
-			err = rows.Scan(&channelId)
+			err = rows.Scan(&channelId, &oneLine)
 
 			if err != nil {
-				updateLogEntries(logId, "Channel id scan error: acl="+modres.ACLFail, "error="+err.Error())
+				updateLogEntries(logId, webhookId, guildId, "Channel id scan error: acl="+modres.ACLFail, "error="+err.Error())
 				state.Logger.Error("Channel id scan error", zap.Error(err), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", webhookId), zap.String("logId", logId))
 				continue
 			}
 
 			channelIds = append(channelIds, channelId)
+
+			if oneLine {
+				oneLineChannels[channelId] = true
+			}
+		}
+	}
+
+	// A matching event modifier sends one-liners to every channel
+	if modres.OneLine {
+		for _, channelId := range channelIds {
+			oneLineChannels[channelId] = true
+		}
+	}
+
+	// Check that the bot can actually send to the channels before rendering anything
+	var sendableChannelIds []string
+
+	for _, channelId := range channelIds {
+		fresh, err := preflightChannel(channelId)
+
+		if err == nil {
+			sendableChannelIds = append(sendableChannelIds, channelId)
+			continue
+		}
+
+		var pfErr *preflightError
+		if !errors.As(err, &pfErr) {
+			// Could not check the channel right now, try sending anyways
+			updateLogEntries(logId, webhookId, guildId, "WARNING: Could not preflight channel, trying to send anyways: channelId="+channelId, "err="+err.Error())
+			state.Logger.Warn("Could not preflight channel", zap.Error(err), zap.String("webhookID", webhookId), zap.String("channelID", channelId), zap.String("logId", logId))
+			sendableChannelIds = append(sendableChannelIds, channelId)
+			continue
+		}
+
+		updateLogEntries(logId, webhookId, guildId, "Preflight failed, skipping channel: channelId="+channelId, "reason="+pfErr.Reason)
+		recordDeliveryFailure(logId, webhookId, guildId, deliveryFailure{
+			ChannelID: channelId,
+			Cause:     brokenCauseChannel,
+			Reason:    "Preflight failed for channel <#" + channelId + ">: " + pfErr.Reason,
+		})
+
+		// Only notify once per preflight check to avoid flooding the fallback channel
+		if fresh {
+			notifyFallbackChannel(guildId, "Could not send event "+header+" to channel <#"+channelId+">: "+pfErr.Reason)
 		}
 	}
 
+	channelIds = sendableChannelIds
+
 	// Early return, don't waste resources if there are no channels to send to
 	if len(channelIds) == 0 {
 		return
 	}
 
+	settings, err := guildsettings.GetGuildSettings(guildId)
+
+	if err != nil {
+		updateLogEntries(logId, webhookId, guildId, "Error fetching guild settings: "+err.Error())
+		state.Logger.Error("Error fetching guild settings", zap.Error(err), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", webhookId), zap.String("guildID", guildId), zap.String("logId", logId))
+		return
+	}
+
+	// Messages are rendered in the language of the guild
+	printer := events.Printer(settings.Locale)
+
 	evtFn, ok := events.SupportedEvents[header]
 
 	var messageSend *discordgo.MessageSend
 
 	if !ok {
 		updateLogEntries(logId, webhookId, guildId, "WARNING: This event cannot be personalized, will try propogating to configured webhooks (if supported)?")
+	} else {
+		// This event can be personalized
+		updateLogEntries(logId, webhookId, guildId, "SUCCESS: This event can be personalized")
+		messageSend, err = renderEvent(evtFn, bodyBytes, printer)
+
+		var rp *renderPanic
+		if errors.As(err, &rp) {
+			// The renderer is broken for this payload, fall back to the generic embed below
+			metricRenderPanics.Add(1)
+			updateLogEntries(logId, webhookId, guildId, "Renderer panicked, falling back to the generic embed:", rp.Error(), "\n"+string(rp.Stack))
+			state.Logger.Error("Renderer panicked", zap.Any("panic", rp.Value), zap.String("stack", string(rp.Stack)), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", webhookId), zap.String("event", header), zap.String("logId", logId))
+		} else if err != nil {
+			metricRenderErrors.Add(1)
+			updateLogEntries(logId, webhookId, guildId, "Error processing event:", err.Error())
+			state.Logger.Error("Error processing event", zap.Error(err), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", webhookId), zap.String("event", header), zap.String("logId", logId))
+
+			// None of the channels get the event, so the failure counts against each of them
+			for _, channelId := range channelIds {
+				recordDeliveryFailure(logId, webhookId, guildId, deliveryFailure{
+					ChannelID: channelId,
+					Cause:     brokenCauseRender,
+					Reason:    "Error processing event " + header + ": " + err.Error(),
+					Event:     header,
+					Payload:   bodyBytes,
+				})
+			}
+
+			return
+		}
+	}
+
+	if messageSend == nil {
+		metricRenderFallbacks.Add(1)
 
-		var fields map[string]any
+		// The generic embed links to the audit log, which only keeps the start of the payload
+		// as it may hold data the guild should not be storing
+		updateLogEntries(logId, webhookId, guildId, "No renderer for event "+header+", sending the generic embed", "payload="+truncatePayload(bodyBytes))
+		messageSend, err = events.Generic(header, bodyBytes, state.Config.APIUrl+"/audit?log_id="+logId, printer)
 
-		if err := json.Unmarshal(bodyBytes, &fields); err != nil {
+		if err != nil {
 			updateLogEntries(logId, webhookId, guildId, "Error unmarshalling event: "+err.Error())
 			state.Logger.Error("Error unmarshalling event", zap.Error(err), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", webhookId), zap.String("logId", logId))
 			return
 		}
+	}
 
-		var embed = discordgo.MessageEmbed{
-			Title:  cases.Title(language.English).String(strings.ReplaceAll(header, "_", " ")),
-			Fields: []*discordgo.MessageEmbedField{},
-		}
+	events.Localize(messageSend, printer)
+	events.ApplyPalette(messageSend, guildPalette(settings))
 
-		for k, v := range fields {
-			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-				Name:  cases.Title(language.English).String(strings.ReplaceAll(k, "_", " ")),
-				Value: cases.Title(language.English).String(strings.ReplaceAll(fmt.Sprintf("%v", v), "_", " ")),
-			})
-		}
+	if settings.EmbedStyle == guildsettings.EmbedStyleCompact {
+		events.Compact(messageSend)
+	}
 
-		messageSend = &discordgo.MessageSend{
-			Embeds: []*discordgo.MessageEmbed{&embed},
-		}
-	} else {
-		// This event can be personalized
-		updateLogEntries(logId, webhookId, guildId, "SUCCESS: This event can be personalized")
-		messageSend, err = evtFn(bodyBytes)
+	// Ping the Discord users linked to the GitHub users this event is about
+	var discordIDs []string
+	if logins, err := events.Mentions(header, bodyBytes); err == nil {
+		discordIDs, err = guildsettings.GetDiscordUsers(guildId, logins)
 
 		if err != nil {
-			updateLogEntries(logId, webhookId, guildId, "Error processing event:", err.Error())
-			state.Logger.Error("Error processing event", zap.Error(err), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", webhookId), zap.String("event", header), zap.String("logId", logId))
-			return
+			updateLogEntries(logId, webhookId, guildId, "Error fetching linked users, sending without mentions: "+err.Error())
+			state.Logger.Error("Error fetching linked users", zap.Error(err), zap.String("guildID", guildId), zap.String("logId", logId))
 		}
 	}
 
+	// One-line channels get the summary as plain message content without embeds
+	var lineSend *discordgo.MessageSend
+	for _, channelId := range channelIds {
+		if oneLineChannels[channelId] {
+			lineSend = &discordgo.MessageSend{
+				Content: events.Summary(header, bodyBytes, messageSend, printer),
+			}
+
+			events.AddMentions(lineSend, modres.MentionRoles, discordIDs)
+			break
+		}
+	}
+
+	events.AddMentions(messageSend, modres.MentionRoles, discordIDs)
+
 	for i, embed := range messageSend.Embeds {
 		messageSend.Embeds[i] = applyEmbedLimits(embed)
 	}
 
 	for _, channelId := range channelIds {
+		// Check if this destination buffers the event into a digest
+		d, err := getDigest(webhookId, channelId, header)
+
+		if err != nil {
+			updateLogEntries(logId, webhookId, guildId, "Error checking digests, sending event directly: channelId="+channelId, "err="+err.Error())
+			state.Logger.Error("Error checking digests", zap.Error(err), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", webhookId), zap.String("channelID", channelId), zap.String("logId", logId))
+		} else if d != nil {
+			updateLogEntries(logId, webhookId, guildId, "Buffering event for digest: channelId="+channelId, "digestId="+d.ID)
+			err = bufferDigestEntry(d, header, newDigestEntry(header, digestKind(header, bodyBytes), messageSend))
+
+			if err != nil {
+				updateLogEntries(logId, webhookId, guildId, "Error buffering event for digest: channelId="+channelId, "digestId="+d.ID, "err="+err.Error())
+				state.Logger.Error("Error buffering event for digest", zap.Error(err), zap.String("repoName", rw.Repo.FullName), zap.String("webhookID", webhookId), zap.String("digestID", d.ID), zap.String("logId", logId))
+			}
+
+			continue
+		}
+
+		// Check the rate limits of this destination
+		if wait := limiter.Reserve(rateLimitRequests(settings, webhookId, channelId)...); wait > 0 {
+			switch settings.RateLimitPolicy {
+			case guildsettings.RateLimitPolicyDrop:
+				updateLogEntries(logId, webhookId, guildId, "Rate limited, dropping event: channelId="+channelId, "wait="+wait.String())
+				continue
+			case guildsettings.RateLimitPolicyCollapse:
+				updateLogEntries(logId, webhookId, guildId, "Rate limited, collapsing event into summary: channelId="+channelId, "wait="+wait.String())
+				collapseEvent(settings, webhookId, channelId, newDigestEntry(header, digestKind(header, bodyBytes), messageSend), wait)
+				continue
+			default:
+				updateLogEntries(logId, webhookId, guildId, "Rate limited, queueing event: channelId="+channelId, "wait="+wait.String())
+				waitForRateLimit(settings, webhookId, channelId)
+			}
+		}
+
+		var send = messageSend
+		if oneLineChannels[channelId] {
+			send = lineSend
+		}
+
 		updateLogEntries(logId, webhookId, guildId, "Sending event to channel: channelId="+channelId)
-		_, err := state.Discord.ChannelMessageSendComplex(channelId, messageSend)
+		_, err = state.Discord.ChannelMessageSendComplex(channelId, send)
 
 		if err != nil {
-			state.Discord.ChannelMessageSendComplex(channelId, &discordgo.MessageSend{
-				Content: "Could not send event " + header + " to channel: <#" + channelId + ">:" + err.Error(),
-			})
+			// The channel itself may be broken, so the error goes to the fallback channel instead
+			notifyFallbackChannel(guildId, "Could not send event "+header+" to channel <#"+channelId+">: "+err.Error())
+
+			updateLogEntries(logId, webhookId, guildId, "Could not send event "+header+" to channel: channelId="+channelId, "err="+err.Error())
+
+			if isDeliveryFailure(err) {
+				invalidatePreflight(channelId)
+				recordDeliveryFailure(logId, webhookId, guildId, deliveryFailure{
+					ChannelID: channelId,
+					Cause:     brokenCauseChannel,
+					Reason:    "Could not send event " + header + " to channel <#" + channelId + ">: " + err.Error(),
+				})
+			}
 
-			updateLogEntries(logId, "Could not send event "+header+" to channel: channelId="+channelId, "err="+err.Error())
+			continue
 		}
+
+		recordDeliverySuccess(webhookId, channelId)
 	}
 }
@@ -0,0 +1,171 @@
+package pneuma
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/git-logs/client/webserver/state"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// How long the result of a channel preflight is cached for
+const preflightTTL = 5 * time.Minute
+
+type permission struct {
+	Name       string
+	Permission int64
+}
+
+// The permissions the bot needs in a destination channel
+var requiredPermissions = []permission{
+	{"View Channel", discordgo.PermissionViewChannel},
+	{"Send Messages", discordgo.PermissionSendMessages},
+	{"Embed Links", discordgo.PermissionEmbedLinks},
+}
+
+// The permissions the bot needs in a destination thread, checked against the parent channel
+var requiredThreadPermissions = []permission{
+	{"View Channel", discordgo.PermissionViewChannel},
+	{"Send Messages in Threads", discordgo.PermissionSendMessagesInThreads},
+	{"Embed Links", discordgo.PermissionEmbedLinks},
+}
+
+// preflightError is a definitive reason why the bot cannot send to a channel, as opposed
+// to a temporary error such as a network failure
+type preflightError struct {
+	Reason string
+}
+
+func (e *preflightError) Error() string {
+	return e.Reason
+}
+
+type preflightResult struct {
+	Err       error
+	CheckedAt time.Time
+}
+
+var (
+	botUserIdMu sync.Mutex
+	botUserId   string
+
+	preflightCacheMu sync.Mutex
+	preflightCache   = map[string]preflightResult{}
+)
+
+// getBotUserId returns the user ID of the bot, fetching it on first use
+func getBotUserId() (string, error) {
+	botUserIdMu.Lock()
+	defer botUserIdMu.Unlock()
+
+	if botUserId != "" {
+		return botUserId, nil
+	}
+
+	u, err := state.Discord.User("@me")
+
+	if err != nil {
+		return "", err
+	}
+
+	botUserId = u.ID
+
+	return botUserId, nil
+}
+
+// checkChannel checks that a channel exists and that the bot can send embeds to it, bypassing
+// (and refreshing) the preflight cache. A *preflightError is returned if the bot definitely
+// cannot send to the channel
+func checkChannel(channelId string) error {
+	err := checkChannelUncached(channelId)
+
+	var pfErr *preflightError
+	if err == nil || errors.As(err, &pfErr) {
+		preflightCacheMu.Lock()
+		preflightCache[channelId] = preflightResult{Err: err, CheckedAt: time.Now()}
+		preflightCacheMu.Unlock()
+	}
+
+	return err
+}
+
+func checkChannelUncached(channelId string) error {
+	userId, err := getBotUserId()
+
+	if err != nil {
+		return err
+	}
+
+	channel, err := state.Discord.Channel(channelId)
+
+	if err != nil {
+		if isDeliveryFailure(err) {
+			return &preflightError{Reason: "channel " + channelId + " does not exist or the bot cannot see it: " + err.Error()}
+		}
+
+		return err
+	}
+
+	var permChannelId = channel.ID
+	var required = requiredPermissions
+
+	if channel.IsThread() {
+		// Threads inherit their permissions from the parent channel
+		permChannelId = channel.ParentID
+		required = requiredThreadPermissions
+
+		if channel.ThreadMetadata != nil && channel.ThreadMetadata.Locked {
+			// Only members who can manage threads can post in (or unlock) a locked thread
+			required = append(required, permission{"Manage Threads", discordgo.PermissionManageThreads})
+		}
+	}
+
+	perms, err := state.Discord.UserChannelPermissions(userId, permChannelId)
+
+	if err != nil {
+		if isDeliveryFailure(err) {
+			return &preflightError{Reason: "could not compute the bots permissions in channel " + channelId + ": " + err.Error()}
+		}
+
+		return err
+	}
+
+	var missing []string
+
+	for _, p := range required {
+		if perms&p.Permission != p.Permission {
+			missing = append(missing, p.Name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &preflightError{Reason: "the bot is missing the following permissions in channel " + channelId + ": " + strings.Join(missing, ", ")}
+	}
+
+	return nil
+}
+
+// preflightChannel returns the cached result of checkChannel, refreshing it once it is older than
+// preflightTTL. fresh is true if the channel was checked just now
+func preflightChannel(channelId string) (fresh bool, err error) {
+	preflightCacheMu.Lock()
+	res, ok := preflightCache[channelId]
+	preflightCacheMu.Unlock()
+
+	if ok && time.Since(res.CheckedAt) < preflightTTL {
+		return false, res.Err
+	}
+
+	return true, checkChannel(channelId)
+}
+
+// invalidatePreflight removes the cached preflight result of a channel, for example when a send
+// to the channel failed despite passing preflight
+func invalidatePreflight(channelId string) {
+	preflightCacheMu.Lock()
+	delete(preflightCache, channelId)
+	preflightCacheMu.Unlock()
+}
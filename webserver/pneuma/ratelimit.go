@@ -0,0 +1,122 @@
+package pneuma
+
+import (
+	"sync"
+	"time"
+
+	"github.com/git-logs/client/webserver/logos/events"
+	"github.com/git-logs/client/webserver/logos/guildsettings"
+	"github.com/git-logs/client/webserver/ratelimit"
+	"github.com/git-logs/client/webserver/state"
+
+	"go.uber.org/zap"
+)
+
+// Limits messages sent per destination channel and per webhook
+var limiter = ratelimit.New[string](ratelimit.RealClock)
+
+type collapseKey struct {
+	WebhookID string
+	ChannelID string
+}
+
+// Events collapsed due to the rate limit, waiting to be sent as one summary
+var (
+	collapsedMu sync.Mutex
+	collapsed   = map[collapseKey][]digestEntry{}
+)
+
+// rateLimitRequests returns the rate limit requests needed to send a message to a channel
+func rateLimitRequests(settings *guildsettings.GuildSettings, webhookId, channelId string) []ratelimit.Request[string] {
+	return []ratelimit.Request[string]{
+		{
+			Key: "channel:" + channelId,
+			Rate: ratelimit.Rate{
+				PerMinute: settings.ChannelRateLimit,
+				Burst:     settings.RateLimitBurst,
+			},
+		},
+		{
+			Key: "webhook:" + webhookId,
+			Rate: ratelimit.Rate{
+				PerMinute: settings.WebhookRateLimit,
+				Burst:     settings.RateLimitBurst,
+			},
+		},
+	}
+}
+
+// waitForRateLimit blocks until a message can be sent to a channel
+func waitForRateLimit(settings *guildsettings.GuildSettings, webhookId, channelId string) {
+	reqs := rateLimitRequests(settings, webhookId, channelId)
+
+	for {
+		wait := limiter.Reserve(reqs...)
+
+		if wait == 0 {
+			return
+		}
+
+		time.Sleep(wait)
+	}
+}
+
+// collapseEvent adds an event to the collapsed summary of a channel, scheduling the summary to
+// be sent once the rate limit allows it
+func collapseEvent(settings *guildsettings.GuildSettings, webhookId, channelId string, entry digestEntry, wait time.Duration) {
+	key := collapseKey{WebhookID: webhookId, ChannelID: channelId}
+
+	collapsedMu.Lock()
+	defer collapsedMu.Unlock()
+
+	collapsed[key] = append(collapsed[key], entry)
+
+	// The first collapsed event schedules the summary, the rest are added to it
+	if len(collapsed[key]) == 1 {
+		time.AfterFunc(wait, func() {
+			flushCollapsed(settings, key)
+		})
+	}
+}
+
+// flushCollapsed sends the collapsed summary of a channel, rescheduling itself if the
+// rate limit still does not allow it
+func flushCollapsed(settings *guildsettings.GuildSettings, key collapseKey) {
+	// Runs on its own timer goroutine
+	defer recoverPanic("Panic while sending collapsed events", zap.String("webhookID", key.WebhookID), zap.String("channelID", key.ChannelID))
+
+	// Send in order with the rest of the webhooks events
+	l := state.MapMutex.Lock(key.WebhookID)
+	defer l.Unlock()
+
+	if wait := limiter.Reserve(rateLimitRequests(settings, key.WebhookID, key.ChannelID)...); wait > 0 {
+		time.AfterFunc(wait, func() {
+			flushCollapsed(settings, key)
+		})
+		return
+	}
+
+	collapsedMu.Lock()
+	entries := collapsed[key]
+	delete(collapsed, key)
+	collapsedMu.Unlock()
+
+	if len(entries) == 0 {
+		return
+	}
+
+	p := events.Printer(settings.Locale)
+
+	messageSend := digestMessage(p, p.Sprintf("%d events collapsed due to rate limits", len(entries)), entries)
+	events.ApplyPalette(messageSend, guildPalette(settings))
+
+	for i, embed := range messageSend.Embeds {
+		messageSend.Embeds[i] = applyEmbedLimits(embed)
+	}
+
+	_, err := state.Discord.ChannelMessageSendComplex(key.ChannelID, messageSend)
+
+	if err != nil {
+		state.Logger.Error("Could not send collapsed events", zap.Error(err), zap.String("webhookID", key.WebhookID), zap.String("channelID", key.ChannelID), zap.Int("count", len(entries)))
+	}
+}
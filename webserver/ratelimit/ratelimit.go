@@ -0,0 +1,175 @@
+// Package ratelimit provides token bucket rate limiting per-key.
+//
+// Each key has its own bucket holding up to Burst tokens, which is refilled at a steady rate. A request
+// may span multiple keys (for example a channel and a webhook) in which case a token is only taken if
+// every bucket has one available.
+//
+// Buckets are tracked using their theoretical arrival time (the time at which the bucket will be full
+// again), which keeps all arithmetic in whole durations and makes the limiter fully deterministic.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// How often buckets that are completely full (and hence equivalent to a new bucket) are removed
+const pruneInterval = 10 * time.Minute
+
+// Clock is the source of time for a Limiter, tests can swap this out for a fake clock
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// RealClock is a Clock backed by time.Now
+var RealClock Clock = realClock{}
+
+// Rate is the rate at which a bucket refills
+type Rate struct {
+	// Number of tokens added per minute
+	PerMinute int
+
+	// Maximum number of tokens a bucket can hold
+	Burst int
+}
+
+// interval returns the time taken to refill one token
+func (r Rate) interval() time.Duration {
+	return time.Minute / time.Duration(r.PerMinute)
+}
+
+// unlimited returns whether the rate does not limit anything
+func (r Rate) unlimited() bool {
+	return r.PerMinute <= 0 || r.Burst <= 0
+}
+
+// Request is a request for a token from the bucket of Key
+type Request[K comparable] struct {
+	Key  K
+	Rate Rate
+}
+
+type bucket struct {
+	// The time at which the bucket will be full again
+	full time.Time
+}
+
+// wait returns how long until the bucket has a token available
+func (b *bucket) wait(rate Rate, now time.Time) time.Duration {
+	full := b.full
+
+	if full.Before(now) {
+		full = now
+	}
+
+	// Being up to Burst-1 tokens in debt still leaves one token to take
+	return full.Sub(now) - time.Duration(rate.Burst-1)*rate.interval()
+}
+
+// take takes a token from the bucket
+func (b *bucket) take(rate Rate, now time.Time) {
+	if b.full.Before(now) {
+		b.full = now
+	}
+
+	b.full = b.full.Add(rate.interval())
+}
+
+// Limiter wraps a map of token buckets. Each key is limited separately.
+type Limiter[K comparable] struct {
+	mu        sync.Mutex
+	clock     Clock
+	buckets   map[K]*bucket
+	lastPrune time.Time
+}
+
+// New returns an initialized Limiter using the given clock
+func New[K comparable](clock Clock) *Limiter[K] {
+	return &Limiter[K]{
+		clock:     clock,
+		buckets:   make(map[K]*bucket),
+		lastPrune: clock.Now(),
+	}
+}
+
+// Reserve checks the buckets of all requests. If every bucket has a token available, a token
+// is taken from each of them and 0 is returned. Otherwise, no tokens are taken and the time until
+// all buckets will have a token available is returned.
+//
+// Requests with a non-positive PerMinute or Burst are treated as unlimited
+func (l *Limiter[K]) Reserve(reqs ...Request[K]) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+
+	l.prune(now)
+
+	var wait time.Duration
+
+	for _, req := range reqs {
+		if req.Rate.unlimited() {
+			continue
+		}
+
+		b, ok := l.buckets[req.Key]
+
+		if !ok {
+			continue
+		}
+
+		wait = max(wait, b.wait(req.Rate, now))
+	}
+
+	if wait > 0 {
+		return wait
+	}
+
+	for _, req := range reqs {
+		if req.Rate.unlimited() {
+			continue
+		}
+
+		b, ok := l.buckets[req.Key]
+
+		if !ok {
+			b = &bucket{full: now}
+			l.buckets[req.Key] = b
+		}
+
+		b.take(req.Rate, now)
+	}
+
+	return 0
+}
+
+// Len returns the number of buckets currently being tracked
+func (l *Limiter[K]) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.buckets)
+}
+
+// prune removes buckets which have been idle long enough to be full again
+//
+// The caller must hold l.mu
+func (l *Limiter[K]) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < pruneInterval {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if !b.full.After(now) {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.lastPrune = now
+}
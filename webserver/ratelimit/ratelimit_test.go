@@ -0,0 +1,148 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestBurst(t *testing.T) {
+	clock := newFakeClock()
+	l := New[string](clock)
+
+	rate := Rate{PerMinute: 6, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		if wait := l.Reserve(Request[string]{Key: "a", Rate: rate}); wait != 0 {
+			t.Fatalf("request %d within burst was limited, wait=%v", i, wait)
+		}
+	}
+
+	// One token is refilled every 10 seconds
+	if wait := l.Reserve(Request[string]{Key: "a", Rate: rate}); wait != 10*time.Second {
+		t.Fatalf("expected a wait of 10s after burst, got %v", wait)
+	}
+
+	clock.Advance(9 * time.Second)
+
+	if wait := l.Reserve(Request[string]{Key: "a", Rate: rate}); wait != time.Second {
+		t.Fatalf("expected a wait of 1s, got %v", wait)
+	}
+
+	clock.Advance(time.Second)
+
+	if wait := l.Reserve(Request[string]{Key: "a", Rate: rate}); wait != 0 {
+		t.Fatalf("expected a token after waiting, got wait=%v", wait)
+	}
+
+	// Other keys are not affected
+	if wait := l.Reserve(Request[string]{Key: "b", Rate: rate}); wait != 0 {
+		t.Fatalf("unrelated key was limited, wait=%v", wait)
+	}
+}
+
+func TestRefillCapsAtBurst(t *testing.T) {
+	clock := newFakeClock()
+	l := New[string](clock)
+
+	rate := Rate{PerMinute: 60, Burst: 2}
+
+	l.Reserve(Request[string]{Key: "a", Rate: rate})
+	l.Reserve(Request[string]{Key: "a", Rate: rate})
+
+	// Idle for far longer than needed to refill
+	clock.Advance(time.Hour)
+
+	for i := 0; i < 2; i++ {
+		if wait := l.Reserve(Request[string]{Key: "a", Rate: rate}); wait != 0 {
+			t.Fatalf("request %d after refill was limited, wait=%v", i, wait)
+		}
+	}
+
+	if wait := l.Reserve(Request[string]{Key: "a", Rate: rate}); wait != time.Second {
+		t.Fatalf("bucket refilled past its burst, wait=%v", wait)
+	}
+}
+
+func TestMultipleKeys(t *testing.T) {
+	clock := newFakeClock()
+	l := New[string](clock)
+
+	channel := Request[string]{Key: "channel", Rate: Rate{PerMinute: 60, Burst: 1}}
+	webhook := Request[string]{Key: "webhook", Rate: Rate{PerMinute: 20, Burst: 2}}
+
+	if wait := l.Reserve(channel, webhook); wait != 0 {
+		t.Fatalf("first request was limited, wait=%v", wait)
+	}
+
+	// The channel is empty, so no token may be taken from the webhook
+	if wait := l.Reserve(channel, webhook); wait != time.Second {
+		t.Fatalf("expected the channel wait of 1s, got %v", wait)
+	}
+
+	clock.Advance(time.Second)
+
+	if wait := l.Reserve(channel, webhook); wait != 0 {
+		t.Fatalf("second request was limited, wait=%v", wait)
+	}
+
+	clock.Advance(time.Second)
+
+	// The channel has a token again, but the webhook needs 3 seconds per token
+	if wait := l.Reserve(channel, webhook); wait != time.Second {
+		t.Fatalf("expected the webhook wait of 1s, got %v", wait)
+	}
+
+	// The failed request above must not have taken the channel token
+	if wait := l.Reserve(channel); wait != 0 {
+		t.Fatalf("channel token was taken by a limited request, wait=%v", wait)
+	}
+}
+
+func TestUnlimited(t *testing.T) {
+	clock := newFakeClock()
+	l := New[string](clock)
+
+	for i := 0; i < 1000; i++ {
+		if wait := l.Reserve(Request[string]{Key: "a", Rate: Rate{}}); wait != 0 {
+			t.Fatalf("unlimited request was limited, wait=%v", wait)
+		}
+	}
+
+	if l.Len() != 0 {
+		t.Errorf("unlimited requests should not create buckets, got %d", l.Len())
+	}
+}
+
+func TestPrune(t *testing.T) {
+	clock := newFakeClock()
+	l := New[string](clock)
+
+	l.Reserve(Request[string]{Key: "idle", Rate: Rate{PerMinute: 60, Burst: 5}})
+	l.Reserve(Request[string]{Key: "busy", Rate: Rate{PerMinute: 1, Burst: 1}})
+
+	clock.Advance(pruneInterval)
+
+	// Keep the busy bucket in debt past the prune
+	l.Reserve(Request[string]{Key: "busy", Rate: Rate{PerMinute: 1, Burst: 1}})
+	l.Reserve(Request[string]{Key: "other", Rate: Rate{PerMinute: 1, Burst: 1}})
+
+	if l.Len() != 2 {
+		t.Errorf("expected the idle bucket to be pruned, %d buckets left", l.Len())
+	}
+}
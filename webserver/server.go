@@ -3,7 +3,9 @@ package main
 import (
 	"net/http"
 	"time"
+
 	"github.com/git-logs/client/webserver/ontos"
+	"github.com/git-logs/client/webserver/pneuma"
 	"github.com/git-logs/client/webserver/state"
 
 	"github.com/go-chi/chi/v5"
@@ -16,6 +18,10 @@ func main() {
 
 	defer state.Close()
 
+	// Background tasks
+	go pneuma.DigestTask()
+	go pneuma.RecoveryTask()
+
 	r := chi.NewMux()
 
 	r.Use(zapchi.Logger(state.Logger.Sugar().Named("zapchi"), "api"), middleware.Recoverer, middleware.RealIP, middleware.RequestID, middleware.Timeout(60*time.Second))
@@ -28,6 +34,8 @@ func main() {
 
 	// API
 	r.HandleFunc("/api/counts", ontos.ApiStats)
+	r.HandleFunc("/api/quotas", ontos.ApiQuotas)
+	r.HandleFunc("/api/metrics", ontos.ApiMetrics)
 	r.HandleFunc("/api/events/listview", ontos.ApiEventsListView)
 	r.HandleFunc("/api/events/csview", ontos.ApiEventsCommaSepView)
 
@@ -20,6 +20,11 @@ var (
 	TableGuilds         = "guilds"
 	TableWebhooks       = "webhooks"
 	TableWebhookLogs    = "webhook_logs"
+	TableDigests        = "digests"
+	TableDigestEntries  = "digest_entries"
+	TableGithubUsers    = "github_users"
+
+	TableWebhookFailures = "webhook_failures"
 
 	TableList = []*string{
 		&TableEventModifiers,
@@ -27,6 +32,10 @@ var (
 		&TableGuilds,
 		&TableWebhooks,
 		&TableWebhookLogs,
+		&TableDigests,
+		&TableDigestEntries,
+		&TableGithubUsers,
+		&TableWebhookFailures,
 	}
 )
 
@@ -123,6 +132,48 @@ func ApplyMigrations() {
 		event_modifiers.last_updated_by TEXT NOT NULL [set unfilled to '']
 
 		webhook_logs.webhook_id text not null references webhooks (id) ON UPDATE CASCADE ON DELETE CASCADE [drop all if webhook_id unset]
+
+		digests [new table]
+		digest_entries [new table]
+
+		guilds.rate_limit_policy TEXT NOT NULL DEFAULT 'queue'
+		guilds.channel_rate_limit INTEGER NOT NULL DEFAULT 20
+		guilds.webhook_rate_limit INTEGER NOT NULL DEFAULT 30
+		guilds.rate_limit_burst INTEGER NOT NULL DEFAULT 5
+
+		guilds.fallback_channel TEXT
+		webhook_failures [new table]
+		webhooks.broken_cause TEXT
+		webhooks.broken_channel TEXT
+		webhooks.broken_reason TEXT
+		webhooks.broken_event TEXT
+		webhooks.broken_payload TEXT
+
+		guilds.max_deliveries_per_hour INTEGER DEFAULT 5000 [NULL for no quota]
+		guilds.max_webhooks INTEGER DEFAULT 5 [the limit the bot used to hard-code, NULL for no quota]
+		guilds.max_repos INTEGER DEFAULT 250 [NULL for no quota]
+		webhook_logs.created_at TIMESTAMPTZ NOT NULL DEFAULT NOW() [indexed with guild_id]
+
+		webhooks.default_channel TEXT
+
+		guilds.locale TEXT NOT NULL DEFAULT 'en'
+
+		github_users [new table]
+
+		event_modifiers.mention_role TEXT
+		event_modifiers.mention_conditions TEXT[] NOT NULL DEFAULT '{}'
+
+		guilds.embed_style TEXT NOT NULL DEFAULT 'full'
+		guilds.color_success INTEGER
+		guilds.color_failure INTEGER
+		guilds.color_warning INTEGER
+		guilds.color_neutral INTEGER
+		guilds.color_info INTEGER
+
+		repos.one_line BOOLEAN NOT NULL DEFAULT false
+		event_modifiers.one_line BOOLEAN NOT NULL DEFAULT false
+
+		guilds.color_severe INTEGER
 	*/
 
 	tx, err := Pool.Begin(Context)
@@ -181,6 +232,82 @@ func ApplyMigrations() {
 		ALTER TABLE `+TableWebhookLogs+` ADD COLUMN IF NOT EXISTS guild_id TEXT NOT NULL REFERENCES `+TableGuilds+` (id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 		ALTER TABLE `+TableWebhooks+` ADD COLUMN IF NOT EXISTS broken BOOLEAN NOT NULL DEFAULT false;
+
+		CREATE TABLE IF NOT EXISTS `+TableDigests+` (
+			id TEXT PRIMARY KEY NOT NULL,
+			guild_id TEXT NOT NULL REFERENCES `+TableGuilds+` (id) ON DELETE CASCADE ON UPDATE CASCADE,
+			webhook_id TEXT NOT NULL REFERENCES `+TableWebhooks+` (id) ON DELETE CASCADE ON UPDATE CASCADE,
+			channel_id TEXT NOT NULL,
+			events TEXT[] NOT NULL DEFAULT '{}',
+			interval_minutes INTEGER NOT NULL DEFAULT 60,
+			max_events INTEGER NOT NULL DEFAULT 50,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			created_by TEXT NOT NULL,
+			last_updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			last_updated_by TEXT NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS `+TableDigestEntries+` (
+			id BIGSERIAL PRIMARY KEY,
+			digest_id TEXT NOT NULL REFERENCES `+TableDigests+` (id) ON DELETE CASCADE ON UPDATE CASCADE,
+			event TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			title TEXT NOT NULL,
+			url TEXT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);
+
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS rate_limit_policy TEXT NOT NULL DEFAULT 'queue';
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS channel_rate_limit INTEGER NOT NULL DEFAULT 20;
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS webhook_rate_limit INTEGER NOT NULL DEFAULT 30;
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS rate_limit_burst INTEGER NOT NULL DEFAULT 5;
+
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS fallback_channel TEXT;
+		CREATE TABLE IF NOT EXISTS `+TableWebhookFailures+` (
+			webhook_id TEXT NOT NULL REFERENCES `+TableWebhooks+` (id) ON DELETE CASCADE ON UPDATE CASCADE,
+			channel_id TEXT NOT NULL,
+			consecutive_failures INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY (webhook_id, channel_id)
+		);
+		ALTER TABLE `+TableWebhooks+` ADD COLUMN IF NOT EXISTS broken_cause TEXT;
+		ALTER TABLE `+TableWebhooks+` ADD COLUMN IF NOT EXISTS broken_channel TEXT;
+		ALTER TABLE `+TableWebhooks+` ADD COLUMN IF NOT EXISTS broken_reason TEXT;
+		ALTER TABLE `+TableWebhooks+` ADD COLUMN IF NOT EXISTS broken_event TEXT;
+		ALTER TABLE `+TableWebhooks+` ADD COLUMN IF NOT EXISTS broken_payload TEXT;
+
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS max_deliveries_per_hour INTEGER DEFAULT 5000 CHECK (max_deliveries_per_hour >= 0);
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS max_webhooks INTEGER DEFAULT 5 CHECK (max_webhooks >= 0);
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS max_repos INTEGER DEFAULT 250 CHECK (max_repos >= 0);
+		ALTER TABLE `+TableWebhookLogs+` ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+		CREATE INDEX IF NOT EXISTS `+TableWebhookLogs+`_guild_id_created_at_idx ON `+TableWebhookLogs+` (guild_id, created_at);
+
+		ALTER TABLE `+TableWebhooks+` ADD COLUMN IF NOT EXISTS default_channel TEXT;
+
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS locale TEXT NOT NULL DEFAULT 'en';
+
+		CREATE TABLE IF NOT EXISTS `+TableGithubUsers+` (
+			guild_id TEXT NOT NULL REFERENCES `+TableGuilds+` (id) ON DELETE CASCADE ON UPDATE CASCADE,
+			github_login TEXT NOT NULL,
+			discord_id TEXT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			created_by TEXT NOT NULL,
+			PRIMARY KEY (guild_id, github_login)
+		);
+
+		ALTER TABLE `+TableEventModifiers+` ADD COLUMN IF NOT EXISTS mention_role TEXT;
+		ALTER TABLE `+TableEventModifiers+` ADD COLUMN IF NOT EXISTS mention_conditions TEXT[] NOT NULL DEFAULT '{}';
+
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS embed_style TEXT NOT NULL DEFAULT 'full';
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS color_success INTEGER;
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS color_failure INTEGER;
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS color_warning INTEGER;
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS color_neutral INTEGER;
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS color_info INTEGER;
+
+		ALTER TABLE `+TableRepos+` ADD COLUMN IF NOT EXISTS one_line BOOLEAN NOT NULL DEFAULT false;
+		ALTER TABLE `+TableEventModifiers+` ADD COLUMN IF NOT EXISTS one_line BOOLEAN NOT NULL DEFAULT false;
+
+		ALTER TABLE `+TableGuilds+` ADD COLUMN IF NOT EXISTS color_severe INTEGER;
 	`)
 
 	if err != nil {